@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/export"
+)
+
+// runExport implements the export subcommand, which writes Terraform
+// configuration and import blocks for the objects on an existing proxy.
+func runExport(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: terraform-provider-litellm export [flags]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Writes a .tf file per resource type, with import blocks, for the objects on a LiteLLM proxy.")
+		fmt.Fprintln(stderr)
+		flags.PrintDefaults()
+	}
+
+	endpoint := flags.String("endpoint", "https://api.litellm.io", "Base URL of the LiteLLM proxy")
+	apiKey := flags.String("api-key", "", "Admin API key for the proxy (default $LITELLM_API_KEY)")
+	out := flags.String("out", ".", "Directory to write the .tf files to")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *apiKey == "" {
+		*apiKey = os.Getenv("LITELLM_API_KEY")
+	}
+	if *apiKey == "" {
+		fmt.Fprintln(stderr, "export: an API key is required; set -api-key or LITELLM_API_KEY")
+		return 2
+	}
+
+	files, err := export.Export(client.NewClient(*apiKey, *endpoint), *out)
+	for _, f := range files {
+		fmt.Fprintln(stdout, filepath.Join(*out, f))
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "export: %v\n", err)
+		return 1
+	}
+
+	return 0
+}
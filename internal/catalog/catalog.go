@@ -0,0 +1,125 @@
+// Package catalog holds the LiteLLM providers accepted as model_provider.
+//
+// The list is embedded from providers.json, which mirrors LiteLLM's
+// custom_llm_provider values. Providers the connected proxy reports can be
+// added at runtime with Register.
+package catalog
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/agext/levenshtein"
+)
+
+//go:embed providers.json
+var providersJSON []byte
+
+var (
+	mu        sync.RWMutex
+	providers map[string]bool
+	aliases   map[string]string
+)
+
+func init() {
+	var data struct {
+		Providers []string          `json:"providers"`
+		Aliases   map[string]string `json:"aliases"`
+	}
+	if err := json.Unmarshal(providersJSON, &data); err != nil {
+		panic(fmt.Sprintf("invalid embedded provider catalog: %v", err))
+	}
+
+	providers = make(map[string]bool, len(data.Providers))
+	for _, name := range data.Providers {
+		providers[name] = true
+	}
+	aliases = data.Aliases
+}
+
+// Register adds provider names reported by the proxy to the catalog.
+func Register(names ...string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	for _, name := range names {
+		if name != "" {
+			providers[name] = true
+		}
+	}
+}
+
+// Providers returns the known provider names, sorted.
+func Providers() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Canonical resolves an alias to its provider name and reports whether the
+// result is a known provider.
+func Canonical(name string) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	normalized := strings.ToLower(name)
+	if target, ok := aliases[normalized]; ok {
+		normalized = target
+	}
+	if providers[normalized] {
+		return normalized, true
+	}
+	return name, false
+}
+
+// Suggest returns the known provider or alias closest to name, or "" if
+// nothing is close enough to be a likely typo.
+func Suggest(name string) string {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	candidates := make([]string, 0, len(providers)+len(aliases))
+	for p := range providers {
+		candidates = append(candidates, p)
+	}
+	for a := range aliases {
+		candidates = append(candidates, a)
+	}
+	sort.Strings(candidates)
+
+	best, bestDistance := "", -1
+	for _, candidate := range candidates {
+		distance := levenshtein.Distance(strings.ToLower(name), candidate, nil)
+		if bestDistance == -1 || distance < bestDistance {
+			best, bestDistance = candidate, distance
+		}
+	}
+
+	// Allow roughly one edit per three characters.
+	if bestDistance > len(name)/3+1 {
+		return ""
+	}
+	return best
+}
+
+// Validate returns an error naming the closest match when name is not a
+// known provider or alias.
+func Validate(name string) error {
+	if _, ok := Canonical(name); ok {
+		return nil
+	}
+	if suggestion := Suggest(name); suggestion != "" {
+		return fmt.Errorf("unknown model provider %q, did you mean %q?", name, suggestion)
+	}
+	return fmt.Errorf("unknown model provider %q", name)
+}
@@ -0,0 +1,49 @@
+package catalog
+
+import "testing"
+
+func TestCanonical(t *testing.T) {
+	cases := map[string]string{
+		"openai":       "openai",
+		"vertex":       "vertex_ai",
+		"azure_openai": "azure",
+		"Bedrock":      "bedrock",
+		"hosted_vllm":  "hosted_vllm",
+	}
+	for name, want := range cases {
+		got, ok := Canonical(name)
+		if !ok || got != want {
+			t.Errorf("Canonical(%q) = %q, %t; want %q, true", name, got, ok, want)
+		}
+	}
+
+	if _, ok := Canonical("not-a-provider"); ok {
+		t.Errorf("Canonical(%q) reported a known provider", "not-a-provider")
+	}
+}
+
+func TestValidateSuggestsClosestProvider(t *testing.T) {
+	err := Validate("bedrok")
+	if err == nil {
+		t.Fatal("expected an error for an unknown provider")
+	}
+	if want := `unknown model provider "bedrok", did you mean "bedrock"?`; err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+
+	if err := Validate("zzzzzzzzzzzz"); err == nil || err.Error() != `unknown model provider "zzzzzzzzzzzz"` {
+		t.Errorf("expected no suggestion for an unrelated name, got %v", err)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	if _, ok := Canonical("brand_new_provider"); ok {
+		t.Fatal("provider known before registering it")
+	}
+
+	Register("brand_new_provider")
+
+	if _, ok := Canonical("brand_new_provider"); !ok {
+		t.Error("registered provider is not known")
+	}
+}
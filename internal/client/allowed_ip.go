@@ -0,0 +1,58 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type allowedIP struct {
+	IP string `json:"ip"`
+}
+
+// Allowed IP operations
+func (c *Client) AddAllowedIP(ip string) error {
+	if ip == "" {
+		return fmt.Errorf("IP address cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/add/allowed_ip", &allowedIP{IP: ip})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// ListAllowedIPs returns the proxy's IP allowlist. There is no dedicated list
+// endpoint, so this reads the allowed_ips general setting.
+func (c *Client) ListAllowedIPs() ([]string, error) {
+	resp, err := c.doRequest("GET", "/config/field/info?field_name=allowed_ips", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		FieldValue []string `json:"field_value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.FieldValue, nil
+}
+
+func (c *Client) DeleteAllowedIP(ip string) error {
+	if ip == "" {
+		return fmt.Errorf("IP address cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/delete/allowed_ip", &allowedIP{IP: ip})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
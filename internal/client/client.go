@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 type Client struct {
@@ -14,22 +16,271 @@ type Client struct {
 	client   *http.Client
 }
 
+// Model is a single deployment. Name is the public model name, which several
+// deployments may share; ModelInfo.ID identifies the deployment. The other
+// fields are sent as litellm_params.
 type Model struct {
-	Name          string            `json:"name"`
-	ModelProvider string            `json:"model_provider"`
-	ModelName     string            `json:"model_name"`
-	APIBase       string            `json:"api_base,omitempty"`
-	APIKey        string            `json:"api_key,omitempty"`
-	Metadata      map[string]string `json:"metadata,omitempty"`
+	Name                  string                 `json:"-"`
+	ModelProvider         string                 `json:"-"`
+	ModelName             string                 `json:"-"`
+	ModelInfo             ModelInfo              `json:"-"`
+	APIBase               string                 `json:"api_base,omitempty"`
+	APIKey                string                 `json:"api_key,omitempty"`
+	LiteLLMCredentialName string                 `json:"litellm_credential_name,omitempty"`
+	Metadata              map[string]interface{} `json:"metadata,omitempty"`
+
+	APIVersion                     string  `json:"api_version,omitempty"`
+	RPM                            int     `json:"rpm,omitempty"`
+	TPM                            int     `json:"tpm,omitempty"`
+	Timeout                        float64 `json:"timeout,omitempty"`
+	StreamTimeout                  float64 `json:"stream_timeout,omitempty"`
+	MaxRetries                     int     `json:"max_retries,omitempty"`
+	Organization                   string  `json:"organization,omitempty"`
+	RegionName                     string  `json:"region_name,omitempty"`
+	InputCostPerToken              float64 `json:"input_cost_per_token,omitempty"`
+	OutputCostPerToken             float64 `json:"output_cost_per_token,omitempty"`
+	InputCostPerSecond             float64 `json:"input_cost_per_second,omitempty"`
+	OutputCostPerSecond            float64 `json:"output_cost_per_second,omitempty"`
+	InputCostPerPixel              float64 `json:"input_cost_per_pixel,omitempty"`
+	OutputCostPerPixel             float64 `json:"output_cost_per_pixel,omitempty"`
+	MaxBudget                      float64 `json:"max_budget,omitempty"`
+	BudgetDuration                 string  `json:"budget_duration,omitempty"`
+	UseInPassThrough               bool    `json:"use_in_pass_through,omitempty"`
+	MergeReasoningContentInChoices bool    `json:"merge_reasoning_content_in_choices,omitempty"`
+	MaxFileSizeMB                  float64 `json:"max_file_size_mb,omitempty"`
+	Order                          int     `json:"order,omitempty"`
+	Weight                         float64 `json:"weight,omitempty"`
+
+	AWSAccessKeyID     string `json:"aws_access_key_id,omitempty"`
+	AWSSecretAccessKey string `json:"aws_secret_access_key,omitempty"`
+	AWSSessionToken    string `json:"aws_session_token,omitempty"`
+	AWSRegionName      string `json:"aws_region_name,omitempty"`
+	AWSRoleName        string `json:"aws_role_name,omitempty"`
+	AWSSessionName     string `json:"aws_session_name,omitempty"`
+	VertexProject      string `json:"vertex_project,omitempty"`
+	VertexLocation     string `json:"vertex_location,omitempty"`
+	VertexCredentials  string `json:"vertex_credentials,omitempty"`
+	AzureADToken       string `json:"azure_ad_token,omitempty"`
+	TenantID           string `json:"tenant_id,omitempty"`
+	ClientID           string `json:"client_id,omitempty"`
+	ClientSecret       string `json:"client_secret,omitempty"`
+	WatsonxRegionName  string `json:"watsonx_region_name,omitempty"`
+	ProjectID          string `json:"project_id,omitempty"`
+}
+
+type ModelInfo struct {
+	ID                              string   `json:"id,omitempty"`
+	Mode                            string   `json:"mode,omitempty"`
+	BaseModel                       string   `json:"base_model,omitempty"`
+	Tier                            string   `json:"tier,omitempty"`
+	AccessGroups                    []string `json:"access_groups,omitempty"`
+	TeamID                          string   `json:"team_id,omitempty"`
+	TeamPublicModelName             string   `json:"team_public_model_name,omitempty"`
+	SupportsVision                  bool     `json:"supports_vision,omitempty"`
+	SupportsFunctionCalling         bool     `json:"supports_function_calling,omitempty"`
+	SupportsParallelFunctionCalling bool     `json:"supports_parallel_function_calling,omitempty"`
+	SupportsResponseSchema          bool     `json:"supports_response_schema,omitempty"`
+
+	// Set by the proxy.
+	CreatedAt string `json:"created_at,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+	CreatedBy string `json:"created_by,omitempty"`
+	DBModel   bool   `json:"db_model,omitempty"`
+}
+
+// modelParams has Model's fields without its JSON methods, so they can be
+// encoded as litellm_params.
+type modelParams Model
+
+// MarshalJSON encodes the model in the shape /model/new and /model/update
+// expect, with provider and model joined into litellm_params.model.
+func (m Model) MarshalJSON() ([]byte, error) {
+	encoded, err := json.Marshal(modelParams(m))
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]interface{})
+	if err := json.Unmarshal(encoded, &params); err != nil {
+		return nil, err
+	}
+	params["model"] = fmt.Sprintf("%s/%s", m.ModelProvider, m.ModelName)
+
+	return json.Marshal(struct {
+		ModelName     string                 `json:"model_name"`
+		LiteLLMParams map[string]interface{} `json:"litellm_params"`
+		ModelInfo     ModelInfo              `json:"model_info"`
+	}{
+		ModelName:     m.Name,
+		LiteLLMParams: params,
+		ModelInfo:     m.ModelInfo,
+	})
+}
+
+// UnmarshalJSON decodes a deployment as returned by /model/info and
+// /model/new.
+func (m *Model) UnmarshalJSON(data []byte) error {
+	var wire struct {
+		ModelID       string          `json:"model_id"`
+		ModelName     string          `json:"model_name"`
+		LiteLLMParams json.RawMessage `json:"litellm_params"`
+		ModelInfo     ModelInfo       `json:"model_info"`
+	}
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	var params modelParams
+	var target struct {
+		Model string `json:"model"`
+	}
+	if len(wire.LiteLLMParams) > 0 {
+		if err := unmarshalUseNumber(wire.LiteLLMParams, &params); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(wire.LiteLLMParams, &target); err != nil {
+			return err
+		}
+	}
+
+	*m = Model(params)
+	m.Name = wire.ModelName
+	m.ModelInfo = wire.ModelInfo
+	if m.ModelInfo.ID == "" {
+		m.ModelInfo.ID = wire.ModelID
+	}
+
+	if i := strings.Index(target.Model, "/"); i >= 0 {
+		m.ModelProvider, m.ModelName = target.Model[:i], target.Model[i+1:]
+	} else {
+		m.ModelName = target.Model
+	}
+
+	return nil
+}
+
+// unmarshalUseNumber is json.Unmarshal, except that numbers in free-form
+// values such as metadata are decoded as json.Number rather than float64, so
+// large integers keep their precision.
+func unmarshalUseNumber(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return dec.Decode(v)
 }
 
 type Key struct {
-	KeyAlias  string   `json:"key_alias"`
-	TeamID    string   `json:"team_id"`
-	Models    []string `json:"models,omitempty"`
-	MaxBudget float64  `json:"max_budget,omitempty"`
-	ExpiresAt string   `json:"expires_at,omitempty"`
-	Key       string   `json:"key,omitempty"`
+	KeyAlias             string                  `json:"key_alias"`
+	TeamID               string                  `json:"team_id"`
+	UserID               string                  `json:"user_id,omitempty"`
+	Models               []string                `json:"models,omitempty"`
+	MaxBudget            float64                 `json:"max_budget,omitempty"`
+	SoftBudget           float64                 `json:"soft_budget,omitempty"`
+	BudgetDuration       string                  `json:"budget_duration,omitempty"`
+	Duration             string                  `json:"duration,omitempty"`
+	ExpiresAt            string                  `json:"expires_at,omitempty"`
+	TPMLimit             int                     `json:"tpm_limit,omitempty"`
+	RPMLimit             int                     `json:"rpm_limit,omitempty"`
+	MaxParallelRequests  int                     `json:"max_parallel_requests,omitempty"`
+	Metadata             map[string]interface{}  `json:"metadata,omitempty"`
+	Aliases              map[string]string       `json:"aliases,omitempty"`
+	Config               map[string]interface{}  `json:"config,omitempty"`
+	Permissions          map[string]bool         `json:"permissions,omitempty"`
+	ModelMaxBudget       map[string]BudgetConfig `json:"model_max_budget,omitempty"`
+	ModelRPMLimit        map[string]int          `json:"model_rpm_limit,omitempty"`
+	ModelTPMLimit        map[string]int          `json:"model_tpm_limit,omitempty"`
+	AllowedCacheControls []string                `json:"allowed_cache_controls,omitempty"`
+	Guardrails           []string                `json:"guardrails,omitempty"`
+	EnforcedParams       []string                `json:"enforced_params,omitempty"`
+	AllowedRoutes        []string                `json:"allowed_routes,omitempty"`
+	Key                  string                  `json:"key,omitempty"`
+	Tags                 []string                `json:"tags,omitempty"`
+	Blocked              bool                    `json:"blocked,omitempty"`
+	Token                string                  `json:"token,omitempty"`
+	TokenID              string                  `json:"token_id,omitempty"`
+	Spend                float64                 `json:"spend,omitempty"`
+	BudgetResetAt        string                  `json:"budget_reset_at,omitempty"`
+	CreatedAt            string                  `json:"created_at,omitempty"`
+	LastActive           string                  `json:"last_active,omitempty"`
+}
+
+// UnmarshalJSON decodes a key as returned by /key/info and /key/list. The
+// proxy stores some of the settings /key/generate takes elsewhere: tags,
+// guardrails, enforced_params and the per-model rate limits in metadata,
+// soft_budget in litellm_budget_table and the expiry in expires. They are
+// moved to their Key fields and removed from Metadata, which is left with the
+// user's own entries.
+func (k *Key) UnmarshalJSON(data []byte) error {
+	type key Key
+	var wire struct {
+		key
+		Expires            string       `json:"expires"`
+		LiteLLMBudgetTable *BudgetTable `json:"litellm_budget_table"`
+	}
+	if err := unmarshalUseNumber(data, &wire); err != nil {
+		return err
+	}
+
+	*k = Key(wire.key)
+	if wire.Expires != "" {
+		k.ExpiresAt = wire.Expires
+	}
+	if wire.LiteLLMBudgetTable != nil && wire.LiteLLMBudgetTable.SoftBudget != 0 {
+		k.SoftBudget = wire.LiteLLMBudgetTable.SoftBudget
+	}
+
+	metadataFields := map[string]interface{}{
+		"tags":            &k.Tags,
+		"guardrails":      &k.Guardrails,
+		"enforced_params": &k.EnforcedParams,
+		"model_rpm_limit": &k.ModelRPMLimit,
+		"model_tpm_limit": &k.ModelTPMLimit,
+	}
+	for name, field := range metadataFields {
+		value, ok := k.Metadata[name]
+		if !ok {
+			continue
+		}
+		delete(k.Metadata, name)
+		if value == nil {
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(encoded, field); err != nil {
+			return fmt.Errorf("failed to decode metadata.%s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// emptyKeyFields are the values UpdateKey sends to clear a Key field: an
+// empty list or object for collections, and null for the rest.
+var emptyKeyFields = map[string]interface{}{
+	"models":                 []string{},
+	"metadata":               map[string]interface{}{},
+	"aliases":                map[string]string{},
+	"config":                 map[string]interface{}{},
+	"permissions":            map[string]bool{},
+	"model_max_budget":       map[string]BudgetConfig{},
+	"model_rpm_limit":        map[string]int{},
+	"model_tpm_limit":        map[string]int{},
+	"allowed_cache_controls": []string{},
+	"guardrails":             []string{},
+	"enforced_params":        []string{},
+	"allowed_routes":         []string{},
+	"tags":                   []string{},
+}
+
+// BudgetConfig is a budget and rate limit applied to a single model.
+type BudgetConfig struct {
+	MaxBudget      float64 `json:"max_budget,omitempty"`
+	BudgetDuration string  `json:"budget_duration,omitempty"`
+	TPMLimit       int     `json:"tpm_limit,omitempty"`
+	RPMLimit       int     `json:"rpm_limit,omitempty"`
 }
 
 func NewClient(apiKey, endpoint string) *Client {
@@ -75,62 +326,109 @@ func (c *Client) doRequest(method, path string, body interface{}) (*http.Respons
 }
 
 // Model operations
+
+// CreateModel adds a deployment through /model/new. On success
+// model.ModelInfo.ID holds the deployment ID, which is generated by the
+// proxy unless it was set.
 func (c *Client) CreateModel(model *Model) error {
 	if err := validateModel(model); err != nil {
 		return err
 	}
 
-	resp, err := c.doRequest("POST", "/api/models", model)
+	resp, err := c.doRequest("POST", "/model/new", model)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(model)
+	var created Model
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if created.ModelInfo.ID != "" {
+		model.ModelInfo.ID = created.ModelInfo.ID
+	}
+	if model.ModelInfo.ID == "" {
+		return fmt.Errorf("model %s was created but the proxy did not return its ID", model.Name)
+	}
+
+	return nil
 }
 
-func (c *Client) GetModel(name string) (*Model, error) {
-	if name == "" {
-		return nil, fmt.Errorf("model name cannot be empty")
+// GetModel reads a deployment by its model_info.id.
+func (c *Client) GetModel(id string) (*Model, error) {
+	if id == "" {
+		return nil, fmt.Errorf("model ID cannot be empty")
 	}
 
-	resp, err := c.doRequest("GET", fmt.Sprintf("/api/models/%s", name), nil)
+	models, err := c.listModels(fmt.Sprintf("/model/info?litellm_model_id=%s", url.QueryEscape(id)))
 	if err != nil {
 		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
 			return nil, nil
 		}
 		return nil, err
 	}
+
+	for i := range models {
+		if models[i].ModelInfo.ID == id {
+			return &models[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ListModels returns every deployment on the proxy.
+func (c *Client) ListModels() ([]Model, error) {
+	return c.listModels("/model/info")
+}
+
+func (c *Client) listModels(path string) ([]Model, error) {
+	resp, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
 	defer resp.Body.Close()
 
-	var model Model
-	if err := json.NewDecoder(resp.Body).Decode(&model); err != nil {
+	var result struct {
+		Data []Model `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return &model, nil
+	return result.Data, nil
 }
 
+// UpdateModel replaces the settings of the deployment model.ModelInfo.ID,
+// including its public name.
 func (c *Client) UpdateModel(model *Model) error {
 	if err := validateModel(model); err != nil {
 		return err
 	}
+	if model.ModelInfo.ID == "" {
+		return fmt.Errorf("model ID cannot be empty")
+	}
 
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/api/models/%s", model.Name), model)
+	resp, err := c.doRequest("POST", "/model/update", model)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(model)
+	return nil
 }
 
-func (c *Client) DeleteModel(name string) error {
-	if name == "" {
-		return fmt.Errorf("model name cannot be empty")
+func (c *Client) DeleteModel(id string) error {
+	if id == "" {
+		return fmt.Errorf("model ID cannot be empty")
 	}
 
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/api/models/%s", name), nil)
+	body := struct {
+		ID string `json:"id"`
+	}{ID: id}
+
+	resp, err := c.doRequest("POST", "/model/delete", &body)
 	if err != nil {
 		return err
 	}
@@ -140,26 +438,37 @@ func (c *Client) DeleteModel(name string) error {
 }
 
 // Key operations
+
+// CreateKey generates a key through /key/generate. On success key.Key holds
+// the secret and key.Token the hashed key that identifies it.
 func (c *Client) CreateKey(key *Key) error {
 	if err := validateKey(key); err != nil {
 		return err
 	}
 
-	resp, err := c.doRequest("POST", "/api/keys", key)
+	resp, err := c.doRequest("POST", "/key/generate", key)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(key)
+	if err := json.NewDecoder(resp.Body).Decode(key); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if key.Token == "" {
+		key.Token = key.TokenID
+	}
+
+	return nil
 }
 
-func (c *Client) GetKey(keyAlias string) (*Key, error) {
-	if keyAlias == "" {
-		return nil, fmt.Errorf("key alias cannot be empty")
+// GetKey reads a key by its hashed token.
+func (c *Client) GetKey(token string) (*Key, error) {
+	if token == "" {
+		return nil, fmt.Errorf("key token cannot be empty")
 	}
 
-	resp, err := c.doRequest("GET", fmt.Sprintf("/api/keys/%s", keyAlias), nil)
+	resp, err := c.doRequest("GET", fmt.Sprintf("/key/info?key=%s", url.QueryEscape(token)), nil)
 	if err != nil {
 		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
 			return nil, nil
@@ -168,34 +477,190 @@ func (c *Client) GetKey(keyAlias string) (*Key, error) {
 	}
 	defer resp.Body.Close()
 
-	var key Key
-	if err := json.NewDecoder(resp.Body).Decode(&key); err != nil {
+	var result struct {
+		Key  string `json:"key"`
+		Info *Key   `json:"info"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	if result.Info == nil {
+		return nil, nil
+	}
+	if result.Info.Token == "" {
+		result.Info.Token = result.Key
+	}
 
-	return &key, nil
+	return result.Info, nil
 }
 
-func (c *Client) UpdateKey(key *Key) error {
+// ListKeys returns the keys with the given alias. Aliases are not unique, so
+// there may be several.
+func (c *Client) ListKeys(keyAlias string) ([]Key, error) {
+	if keyAlias == "" {
+		return nil, fmt.Errorf("key alias cannot be empty")
+	}
+
+	path := fmt.Sprintf("/key/list?key_alias=%s&return_full_object=true", url.QueryEscape(keyAlias))
+	resp, err := c.doRequest("GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Keys []Key `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	// The proxy may ignore the filter on older versions, so apply it here too.
+	keys := make([]Key, 0, len(result.Keys))
+	for _, key := range result.Keys {
+		if key.KeyAlias == keyAlias {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+// ListAllKeys returns every key on the proxy, following /key/list's pages.
+func (c *Client) ListAllKeys() ([]Key, error) {
+	var keys []Key
+	for page := 1; ; page++ {
+		resp, err := c.doRequest("GET", fmt.Sprintf("/key/list?return_full_object=true&page=%d&size=100", page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result struct {
+			Keys       []Key `json:"keys"`
+			TotalPages int   `json:"total_pages"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		for _, key := range result.Keys {
+			if key.Token == "" {
+				key.Token = key.TokenID
+			}
+			keys = append(keys, key)
+		}
+
+		if page >= result.TotalPages || len(result.Keys) == 0 {
+			return keys, nil
+		}
+	}
+}
+
+// UpdateKey applies key's settings, including its alias, to the key
+// identified by token. Empty fields of key are left out of the request and so keep their current value;
+// the fields named in clear, by their JSON name, are sent empty instead, which
+// removes them from the key.
+func (c *Client) UpdateKey(token string, key *Key, clear ...string) error {
+	if token == "" {
+		return fmt.Errorf("key token cannot be empty")
+	}
 	if err := validateKey(key); err != nil {
 		return err
 	}
 
-	resp, err := c.doRequest("PUT", fmt.Sprintf("/api/keys/%s", key.KeyAlias), key)
+	k := *key
+	k.Key = token
+
+	encoded, err := json.Marshal(&k)
+	if err != nil {
+		return fmt.Errorf("failed to encode request body: %w", err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(encoded, &body); err != nil {
+		return fmt.Errorf("failed to encode request body: %w", err)
+	}
+	for _, field := range clear {
+		body[field] = emptyKeyFields[field]
+	}
+
+	resp, err := c.doRequest("POST", "/key/update", body)
 	if err != nil {
 		return err
 	}
 	defer resp.Body.Close()
 
-	return json.NewDecoder(resp.Body).Decode(key)
+	return nil
 }
 
-func (c *Client) DeleteKey(keyAlias string) error {
-	if keyAlias == "" {
-		return fmt.Errorf("key alias cannot be empty")
+func (c *Client) DeleteKey(token string) error {
+	if token == "" {
+		return fmt.Errorf("key token cannot be empty")
+	}
+
+	body := struct {
+		Keys []string `json:"keys"`
+	}{Keys: []string{token}}
+
+	resp, err := c.doRequest("POST", "/key/delete", &body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// BlockKey rejects all requests made with the key without deleting it, so its
+// spend history stays attached. key may be the raw or the hashed key.
+func (c *Client) BlockKey(key string) error {
+	return c.setKeyBlocked("/key/block", key)
+}
+
+// UnblockKey allows a blocked key to make requests again.
+func (c *Client) UnblockKey(key string) error {
+	return c.setKeyBlocked("/key/unblock", key)
+}
+
+// RegenerateKey replaces the secret of an existing key, keeping its settings
+// and spend. key may be the raw or the hashed key. The returned Key carries
+// the new secret and its hashed token.
+func (c *Client) RegenerateKey(key string) (*Key, error) {
+	if key == "" {
+		return nil, fmt.Errorf("key cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", fmt.Sprintf("/key/%s/regenerate", url.PathEscape(key)), struct{}{})
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
+
+	var regenerated Key
+	if err := json.NewDecoder(resp.Body).Decode(&regenerated); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if regenerated.Key == "" {
+		return nil, fmt.Errorf("regenerate response did not include a new key")
+	}
+	if regenerated.Token == "" {
+		regenerated.Token = regenerated.TokenID
+	}
+
+	return &regenerated, nil
+}
+
+func (c *Client) setKeyBlocked(path, key string) error {
+	if key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+
+	body := struct {
+		Key string `json:"key"`
+	}{Key: key}
 
-	resp, err := c.doRequest("DELETE", fmt.Sprintf("/api/keys/%s", keyAlias), nil)
+	resp, err := c.doRequest("POST", path, &body)
 	if err != nil {
 		return err
 	}
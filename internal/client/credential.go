@@ -0,0 +1,110 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Credential is a named set of provider credentials that model deployments
+// can reference instead of carrying their own API keys.
+type Credential struct {
+	CredentialName   string            `json:"credential_name"`
+	CredentialValues map[string]string `json:"credential_values,omitempty"`
+	CredentialInfo   map[string]string `json:"credential_info"`
+}
+
+// Credential operations
+func (c *Client) CreateCredential(credential *Credential) error {
+	if err := validateCredential(credential); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/credentials", credential)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) GetCredential(name string) (*Credential, error) {
+	if name == "" {
+		return nil, fmt.Errorf("credential name cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/credentials/by_name/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var credential Credential
+	if err := json.NewDecoder(resp.Body).Decode(&credential); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &credential, nil
+}
+
+// ListCredentials returns every credential on the proxy, with their values
+// masked.
+func (c *Client) ListCredentials() ([]Credential, error) {
+	resp, err := c.doRequest("GET", "/credentials", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Credentials []Credential `json:"credentials"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Credentials, nil
+}
+
+func (c *Client) UpdateCredential(credential *Credential) error {
+	if err := validateCredential(credential); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("PATCH", fmt.Sprintf("/credentials/%s", url.PathEscape(credential.CredentialName)), credential)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) DeleteCredential(name string) error {
+	if name == "" {
+		return fmt.Errorf("credential name cannot be empty")
+	}
+
+	resp, err := c.doRequest("DELETE", fmt.Sprintf("/credentials/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func validateCredential(credential *Credential) error {
+	if credential == nil {
+		return fmt.Errorf("credential cannot be nil")
+	}
+	if credential.CredentialName == "" {
+		return fmt.Errorf("credential name cannot be empty")
+	}
+	return nil
+}
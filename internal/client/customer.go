@@ -0,0 +1,221 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Customer is an end user of the proxy, tracked through the `user` param on
+// completion requests.
+type Customer struct {
+	UserID              string       `json:"user_id"`
+	Alias               string       `json:"alias,omitempty"`
+	Blocked             bool         `json:"blocked"`
+	AllowedModelRegion  string       `json:"allowed_model_region,omitempty"`
+	DefaultModel        string       `json:"default_model,omitempty"`
+	BudgetID            string       `json:"budget_id,omitempty"`
+	MaxBudget           float64      `json:"max_budget,omitempty"`
+	SoftBudget          float64      `json:"soft_budget,omitempty"`
+	BudgetDuration      string       `json:"budget_duration,omitempty"`
+	TPMLimit            int          `json:"tpm_limit,omitempty"`
+	RPMLimit            int          `json:"rpm_limit,omitempty"`
+	MaxParallelRequests int          `json:"max_parallel_requests,omitempty"`
+	Spend               float64      `json:"spend,omitempty"`
+	Budget              *BudgetTable `json:"litellm_budget_table,omitempty"`
+}
+
+// BudgetTable is the budget record the proxy attaches to customers, users and
+// organizations.
+type BudgetTable struct {
+	BudgetID            string  `json:"budget_id,omitempty"`
+	MaxBudget           float64 `json:"max_budget,omitempty"`
+	SoftBudget          float64 `json:"soft_budget,omitempty"`
+	BudgetDuration      string  `json:"budget_duration,omitempty"`
+	TPMLimit            int     `json:"tpm_limit,omitempty"`
+	RPMLimit            int     `json:"rpm_limit,omitempty"`
+	MaxParallelRequests int     `json:"max_parallel_requests,omitempty"`
+}
+
+type customerIDs struct {
+	UserIDs []string `json:"user_ids"`
+}
+
+// Customer operations
+func (c *Client) CreateCustomer(customer *Customer) error {
+	if err := validateCustomer(customer); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/customer/new", customer)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return json.NewDecoder(resp.Body).Decode(customer)
+}
+
+func (c *Client) GetCustomer(userID string) (*Customer, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("customer user ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/customer/info?end_user_id=%s", url.QueryEscape(userID)), nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var customer Customer
+	if err := json.NewDecoder(resp.Body).Decode(&customer); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	customer.flattenBudget()
+
+	return &customer, nil
+}
+
+func (c *Client) ListCustomers() ([]Customer, error) {
+	resp, err := c.doRequest("GET", "/customer/list", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var customers []Customer
+	if err := json.NewDecoder(resp.Body).Decode(&customers); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	for i := range customers {
+		customers[i].flattenBudget()
+	}
+
+	return customers, nil
+}
+
+func (c *Client) UpdateCustomer(customer *Customer) error {
+	if err := validateCustomer(customer); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/customer/update", customer)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) DeleteCustomer(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("customer user ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/customer/delete", &customerIDs{UserIDs: []string{userID}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) BlockCustomer(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("customer user ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/customer/block", &customerIDs{UserIDs: []string{userID}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) UnblockCustomer(userID string) error {
+	if userID == "" {
+		return fmt.Errorf("customer user ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/customer/unblock", &customerIDs{UserIDs: []string{userID}})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// Budget operations
+
+// CreateBudget creates a budget and sets budget.BudgetID to the ID the proxy
+// generated for it.
+func (c *Client) CreateBudget(budget *BudgetTable) error {
+	if budget == nil {
+		return fmt.Errorf("budget cannot be nil")
+	}
+
+	resp, err := c.doRequest("POST", "/budget/new", budget)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(budget); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateBudget updates the budget budget.BudgetID. Empty fields are left
+// unchanged.
+func (c *Client) UpdateBudget(budget *BudgetTable) error {
+	if budget == nil || budget.BudgetID == "" {
+		return fmt.Errorf("budget ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/budget/update", budget)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// flattenBudget copies the nested budget table returned by /customer/info
+// onto the top-level fields used when writing a customer.
+func (cu *Customer) flattenBudget() {
+	if cu.Budget == nil {
+		return
+	}
+	cu.BudgetID = cu.Budget.BudgetID
+	cu.MaxBudget = cu.Budget.MaxBudget
+	cu.SoftBudget = cu.Budget.SoftBudget
+	cu.BudgetDuration = cu.Budget.BudgetDuration
+	cu.TPMLimit = cu.Budget.TPMLimit
+	cu.RPMLimit = cu.Budget.RPMLimit
+	cu.MaxParallelRequests = cu.Budget.MaxParallelRequests
+}
+
+func validateCustomer(customer *Customer) error {
+	if customer == nil {
+		return fmt.Errorf("customer cannot be nil")
+	}
+	if customer.UserID == "" {
+		return fmt.Errorf("customer user ID cannot be empty")
+	}
+	if customer.BudgetID != "" && customer.MaxBudget != 0 {
+		return fmt.Errorf("only one of budget ID or max budget can be set for a customer")
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PassThroughEndpoint forwards requests on a proxy path to a vendor API.
+type PassThroughEndpoint struct {
+	Path    string            `json:"path"`
+	Target  string            `json:"target"`
+	Headers map[string]string `json:"headers"`
+}
+
+type passThroughEndpointResponse struct {
+	Endpoints []PassThroughEndpoint `json:"endpoints"`
+}
+
+// Pass-through endpoint operations
+func (c *Client) CreatePassThroughEndpoint(endpoint *PassThroughEndpoint) error {
+	if err := validatePassThroughEndpoint(endpoint); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/config/pass_through_endpoint", endpoint)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetPassThroughEndpoint looks up an endpoint by its ID, which the proxy
+// derives from the endpoint's path.
+func (c *Client) GetPassThroughEndpoint(endpointID string) (*PassThroughEndpoint, error) {
+	if endpointID == "" {
+		return nil, fmt.Errorf("pass-through endpoint ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/config/pass_through_endpoint?endpoint_id=%s", url.QueryEscape(endpointID)), nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result passThroughEndpointResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	for _, endpoint := range result.Endpoints {
+		if endpoint.Path == endpointID {
+			return &endpoint, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ListPassThroughEndpoints returns every pass-through endpoint on the proxy.
+func (c *Client) ListPassThroughEndpoints() ([]PassThroughEndpoint, error) {
+	resp, err := c.doRequest("GET", "/config/pass_through_endpoint", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result passThroughEndpointResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Endpoints, nil
+}
+
+func (c *Client) DeletePassThroughEndpoint(endpointID string) error {
+	if endpointID == "" {
+		return fmt.Errorf("pass-through endpoint ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("DELETE", fmt.Sprintf("/config/pass_through_endpoint?endpoint_id=%s", url.QueryEscape(endpointID)), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func validatePassThroughEndpoint(endpoint *PassThroughEndpoint) error {
+	if endpoint == nil {
+		return fmt.Errorf("pass-through endpoint cannot be nil")
+	}
+	if endpoint.Path == "" {
+		return fmt.Errorf("pass-through endpoint path cannot be empty")
+	}
+	if endpoint.Target == "" {
+		return fmt.Errorf("pass-through endpoint target cannot be empty")
+	}
+	return nil
+}
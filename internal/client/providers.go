@@ -0,0 +1,24 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Provider operations
+
+// ListProviders returns the custom_llm_provider names the proxy supports.
+func (c *Client) ListProviders() ([]string, error) {
+	resp, err := c.doRequest("GET", "/public/providers", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var providers []string
+	if err := json.NewDecoder(resp.Body).Decode(&providers); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return providers, nil
+}
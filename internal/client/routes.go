@@ -0,0 +1,32 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Route is an HTTP route served by the proxy.
+type Route struct {
+	Path    string   `json:"path"`
+	Methods []string `json:"methods,omitempty"`
+	Name    string   `json:"name,omitempty"`
+}
+
+// ListRoutes returns every route registered on the proxy, including the
+// built-in management and OpenAI-compatible routes.
+func (c *Client) ListRoutes() ([]Route, error) {
+	resp, err := c.doRequest("GET", "/routes", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Routes []Route `json:"routes"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return result.Routes, nil
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Settings operations
+
+// GetSettings returns the values of a proxy-wide settings object, such as
+// "internal_user_settings" or "default_team_settings".
+func (c *Client) GetSettings(name string) (map[string]interface{}, error) {
+	if name == "" {
+		return nil, fmt.Errorf("settings name cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/get/%s", name), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// The response also carries a field schema for the admin UI.
+	var result struct {
+		Values map[string]interface{} `json:"values"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	if result.Values == nil {
+		result.Values = make(map[string]interface{})
+	}
+
+	return result.Values, nil
+}
+
+// UpdateSettings patches a settings object. Keys left out of values are
+// unchanged; keys with a nil value are sent as null, which unsets them.
+func (c *Client) UpdateSettings(name string, values map[string]interface{}) error {
+	if name == "" {
+		return fmt.Errorf("settings name cannot be empty")
+	}
+
+	resp, err := c.doRequest("PATCH", fmt.Sprintf("/update/%s", name), values)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Tag groups models for tag-based routing and spend attribution.
+type Tag struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Models      []string          `json:"models,omitempty"`
+	ModelInfo   map[string]string `json:"model_info,omitempty"`
+	CreatedAt   string            `json:"created_at,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+	CreatedBy   string            `json:"created_by,omitempty"`
+}
+
+// Tag operations
+func (c *Client) CreateTag(tag *Tag) error {
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/tag/new", tag)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) GetTag(name string) (*Tag, error) {
+	if name == "" {
+		return nil, fmt.Errorf("tag name cannot be empty")
+	}
+
+	body := struct {
+		Names []string `json:"names"`
+	}{Names: []string{name}}
+
+	resp, err := c.doRequest("POST", "/tag/info", &body)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// /tag/info responds with a map keyed by tag name.
+	var tags map[string]Tag
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	tag, ok := tags[name]
+	if !ok {
+		return nil, nil
+	}
+	if tag.Name == "" {
+		tag.Name = name
+	}
+
+	return &tag, nil
+}
+
+func (c *Client) ListTags() ([]Tag, error) {
+	resp, err := c.doRequest("GET", "/tag/list", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var tags []Tag
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return tags, nil
+}
+
+func (c *Client) UpdateTag(tag *Tag) error {
+	if err := validateTag(tag); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/tag/update", tag)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) DeleteTag(name string) error {
+	if name == "" {
+		return fmt.Errorf("tag name cannot be empty")
+	}
+
+	body := struct {
+		Name string `json:"name"`
+	}{Name: name}
+
+	resp, err := c.doRequest("POST", "/tag/delete", &body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func validateTag(tag *Tag) error {
+	if tag == nil {
+		return fmt.Errorf("tag cannot be nil")
+	}
+	if tag.Name == "" {
+		return fmt.Errorf("tag name cannot be empty")
+	}
+	return nil
+}
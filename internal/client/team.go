@@ -0,0 +1,124 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Team is the subset of a team's settings the provider reads.
+type Team struct {
+	TeamID    string   `json:"team_id"`
+	TeamAlias string   `json:"team_alias,omitempty"`
+	Models    []string `json:"models"`
+}
+
+type teamModelsRequest struct {
+	TeamID string   `json:"team_id"`
+	Models []string `json:"models"`
+}
+
+// Team operations
+func (c *Client) GetTeam(teamID string) (*Team, error) {
+	if teamID == "" {
+		return nil, fmt.Errorf("team ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/team/info?team_id=%s", url.QueryEscape(teamID)), nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		TeamInfo Team `json:"team_info"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result.TeamInfo, nil
+}
+
+// AddTeamModels grants models to a team without replacing its existing list.
+func (c *Client) AddTeamModels(teamID string, models []string) error {
+	if teamID == "" {
+		return fmt.Errorf("team ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/team/model/add", &teamModelsRequest{TeamID: teamID, Models: models})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// DeleteTeamModels revokes models from a team, leaving its other models intact.
+func (c *Client) DeleteTeamModels(teamID string, models []string) error {
+	if teamID == "" {
+		return fmt.Errorf("team ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", "/team/model/delete", &teamModelsRequest{TeamID: teamID, Models: models})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// TeamMemberPermissions are the key-management routes members of a team may
+// call, along with every route the proxy allows to be granted.
+type TeamMemberPermissions struct {
+	TeamID                  string   `json:"team_id"`
+	TeamMemberPermissions   []string `json:"team_member_permissions"`
+	AllAvailablePermissions []string `json:"all_available_permissions,omitempty"`
+}
+
+func (c *Client) GetTeamMemberPermissions(teamID string) (*TeamMemberPermissions, error) {
+	if teamID == "" {
+		return nil, fmt.Errorf("team ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/team/permissions_list?team_id=%s", url.QueryEscape(teamID)), nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var permissions TeamMemberPermissions
+	if err := json.NewDecoder(resp.Body).Decode(&permissions); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &permissions, nil
+}
+
+func (c *Client) UpdateTeamMemberPermissions(teamID string, permissions []string) error {
+	if teamID == "" {
+		return fmt.Errorf("team ID cannot be empty")
+	}
+
+	body := &TeamMemberPermissions{
+		TeamID:                teamID,
+		TeamMemberPermissions: permissions,
+	}
+
+	resp, err := c.doRequest("POST", "/team/permissions_update", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
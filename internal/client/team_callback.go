@@ -0,0 +1,81 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TeamCallback is a logging destination added to a single team.
+type TeamCallback struct {
+	CallbackName string            `json:"callback_name"`
+	CallbackType string            `json:"callback_type,omitempty"`
+	CallbackVars map[string]string `json:"callback_vars"`
+}
+
+// TeamCallbackSettings are the logging callbacks currently configured on a
+// team. CallbackVars is shared by all of the team's callbacks.
+type TeamCallbackSettings struct {
+	TeamID           string            `json:"team_id"`
+	SuccessCallbacks []string          `json:"success_callbacks"`
+	FailureCallbacks []string          `json:"failure_callbacks"`
+	CallbackVars     map[string]string `json:"callback_vars"`
+}
+
+// Team callback operations
+func (c *Client) AddTeamCallback(teamID string, callback *TeamCallback) error {
+	if teamID == "" {
+		return fmt.Errorf("team ID cannot be empty")
+	}
+	if callback == nil || callback.CallbackName == "" {
+		return fmt.Errorf("callback name cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", fmt.Sprintf("/team/%s/callback", url.PathEscape(teamID)), callback)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (c *Client) GetTeamCallbacks(teamID string) (*TeamCallbackSettings, error) {
+	if teamID == "" {
+		return nil, fmt.Errorf("team ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("GET", fmt.Sprintf("/team/%s/callback", url.PathEscape(teamID)), nil)
+	if err != nil {
+		if apiErr, ok := err.(*APIError); ok && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data TeamCallbackSettings `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &result.Data, nil
+}
+
+// DisableTeamLogging removes every logging callback from the team.
+func (c *Client) DisableTeamLogging(teamID string) error {
+	if teamID == "" {
+		return fmt.Errorf("team ID cannot be empty")
+	}
+
+	resp, err := c.doRequest("POST", fmt.Sprintf("/team/%s/disable_logging", url.PathEscape(teamID)), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
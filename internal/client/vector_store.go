@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// VectorStore is a provider-hosted vector store registered with the proxy.
+type VectorStore struct {
+	VectorStoreID          string            `json:"vector_store_id"`
+	CustomLLMProvider      string            `json:"custom_llm_provider"`
+	VectorStoreName        string            `json:"vector_store_name,omitempty"`
+	VectorStoreDescription string            `json:"vector_store_description,omitempty"`
+	VectorStoreMetadata    map[string]string `json:"vector_store_metadata,omitempty"`
+	LiteLLMCredentialName  string            `json:"litellm_credential_name,omitempty"`
+	CreatedAt              string            `json:"created_at,omitempty"`
+	UpdatedAt              string            `json:"updated_at,omitempty"`
+}
+
+type vectorStoreListResponse struct {
+	Data       []VectorStore `json:"data"`
+	TotalPages int           `json:"total_pages"`
+}
+
+const vectorStorePageSize = 100
+
+// Vector store operations
+func (c *Client) CreateVectorStore(store *VectorStore) error {
+	if err := validateVectorStore(store); err != nil {
+		return err
+	}
+
+	resp, err := c.doRequest("POST", "/vector_store/new", store)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+// GetVectorStore finds a vector store by ID. The proxy has no info endpoint
+// for vector stores, so this pages through /vector_store/list.
+func (c *Client) GetVectorStore(vectorStoreID string) (*VectorStore, error) {
+	if vectorStoreID == "" {
+		return nil, fmt.Errorf("vector store ID cannot be empty")
+	}
+
+	for page := 1; ; page++ {
+		resp, err := c.doRequest("GET", fmt.Sprintf("/vector_store/list?page=%d&page_size=%d", page, vectorStorePageSize), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result vectorStoreListResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+
+		for _, store := range result.Data {
+			if store.VectorStoreID == vectorStoreID {
+				return &store, nil
+			}
+		}
+
+		if page >= result.TotalPages || len(result.Data) == 0 {
+			return nil, nil
+		}
+	}
+}
+
+func (c *Client) DeleteVectorStore(vectorStoreID string) error {
+	if vectorStoreID == "" {
+		return fmt.Errorf("vector store ID cannot be empty")
+	}
+
+	body := struct {
+		VectorStoreID string `json:"vector_store_id"`
+	}{VectorStoreID: vectorStoreID}
+
+	resp, err := c.doRequest("POST", "/vector_store/delete", &body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func validateVectorStore(store *VectorStore) error {
+	if store == nil {
+		return fmt.Errorf("vector store cannot be nil")
+	}
+	if store.VectorStoreID == "" {
+		return fmt.Errorf("vector store ID cannot be empty")
+	}
+	if store.CustomLLMProvider == "" {
+		return fmt.Errorf("vector store provider cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+func DataSourceAllowedIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAllowedIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Every IP address and CIDR block on the proxy's allowlist",
+			},
+		},
+	}
+}
+
+func dataSourceAllowedIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	ips, err := c.ListAllowedIPs()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("allowed_ips")
+	d.Set("ips", ips)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package datasources
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+func DataSourceCustomers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCustomersRead,
+
+		Schema: map[string]*schema.Schema{
+			"blocked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return customers with this blocked state",
+			},
+			"allowed_model_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return customers restricted to this region",
+			},
+			"default_model": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return customers with this default model",
+			},
+			"alias_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return customers whose alias starts with this prefix",
+			},
+			"customers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Customers matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The end-user ID",
+						},
+						"alias": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-friendly alias for the customer",
+						},
+						"blocked": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether requests for this customer are rejected",
+						},
+						"allowed_model_region": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region the customer's requests are restricted to",
+						},
+						"default_model": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Model used when no equivalent model exists in the allowed region",
+						},
+						"budget_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the budget attached to the customer",
+						},
+						"max_budget": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Maximum spend in USD for the customer",
+						},
+						"budget_duration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "How often the budget resets",
+						},
+						"spend": {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "Total spend in USD recorded for the customer",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCustomersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	customers, err := c.ListCustomers()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	filterBlocked := !d.GetRawConfig().GetAttr("blocked").IsNull()
+	blocked := d.Get("blocked").(bool)
+	region := d.Get("allowed_model_region").(string)
+	defaultModel := d.Get("default_model").(string)
+	aliasPrefix := d.Get("alias_prefix").(string)
+
+	result := make([]interface{}, 0, len(customers))
+	for _, customer := range customers {
+		if filterBlocked && customer.Blocked != blocked {
+			continue
+		}
+		if region != "" && customer.AllowedModelRegion != region {
+			continue
+		}
+		if defaultModel != "" && customer.DefaultModel != defaultModel {
+			continue
+		}
+		if aliasPrefix != "" && !strings.HasPrefix(customer.Alias, aliasPrefix) {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"user_id":              customer.UserID,
+			"alias":                customer.Alias,
+			"blocked":              customer.Blocked,
+			"allowed_model_region": customer.AllowedModelRegion,
+			"default_model":        customer.DefaultModel,
+			"budget_id":            customer.BudgetID,
+			"max_budget":           customer.MaxBudget,
+			"budget_duration":      customer.BudgetDuration,
+			"spend":                customer.Spend,
+		})
+	}
+
+	d.SetId("customers")
+	if err := d.Set("customers", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -2,10 +2,12 @@ package datasources
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/resources"
 )
 
 func DataSourceKey() *schema.Resource {
@@ -20,8 +22,9 @@ func DataSourceKey() *schema.Resource {
 			},
 			"team_id": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "Team ID associated with the key",
+				Description: "Team ID associated with the key. Set it to pick between keys that share an alias",
 			},
 			"models": {
 				Type:     schema.TypeList,
@@ -41,29 +44,242 @@ func DataSourceKey() *schema.Resource {
 				Computed:    true,
 				Description: "Expiration timestamp for the key",
 			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "List of tags attached to requests made with this key",
+			},
+			"blocked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key is blocked",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User the key belongs to",
+			},
+			"soft_budget": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Spend in USD after which budget alerts are sent for this key",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How often the key's spend is reset",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Tokens per minute limit for this key",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Requests per minute limit for this key",
+			},
+			"max_parallel_requests": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of concurrent requests allowed for this key",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary metadata stored with the key. Only string values are included",
+			},
+			"metadata_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Arbitrary metadata stored with the key as a JSON object, including non-string values",
+			},
+			"aliases": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Model aliases for this key",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Key-specific proxy config",
+			},
+			"permissions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeBool},
+				Description: "Key-specific permissions",
+			},
+			"model_max_budget": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Budgets and rate limits applied to individual models",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"model":           {Type: schema.TypeString, Computed: true},
+						"max_budget":      {Type: schema.TypeFloat, Computed: true},
+						"budget_duration": {Type: schema.TypeString, Computed: true},
+						"tpm_limit":       {Type: schema.TypeInt, Computed: true},
+						"rpm_limit":       {Type: schema.TypeInt, Computed: true},
+					},
+				},
+			},
+			"model_rpm_limit": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Requests per minute limits applied to individual models",
+				Elem:        resources.ModelLimitResource(true),
+			},
+			"model_tpm_limit": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Tokens per minute limits applied to individual models",
+				Elem:        resources.ModelLimitResource(true),
+			},
+			"allowed_cache_controls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Cache-control values requests made with this key may set",
+			},
+			"guardrails": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Guardrails applied to requests made with this key",
+			},
+			"enforced_params": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Request parameters that must be present on every request made with this key",
+			},
+			"allowed_routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Proxy routes this key may call",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hashed key the proxy uses to identify the key",
+			},
+			"spend": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Spend in USD on this key since its budget was last reset",
+			},
+			"budget_reset_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the key's spend is next reset",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the key was created",
+			},
+			"last_active": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the key was last used",
+			},
 		},
 	}
 }
 
+// flattenMetadata sets the string values of metadata as `metadata` and the
+// whole object as `metadata_json`.
+func flattenMetadata(d *schema.ResourceData, metadata map[string]interface{}) error {
+	strs, encoded, err := resources.FlattenMetadata(metadata)
+	if err != nil {
+		return err
+	}
+	d.Set("metadata", strs)
+	return d.Set("metadata_json", encoded)
+}
+
 func dataSourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
 	keyAlias := d.Get("key_alias").(string)
 
-	key, err := c.GetKey(keyAlias)
+	teamID := d.Get("team_id").(string)
+
+	keys, err := c.ListKeys(keyAlias)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if key == nil {
+	var matches []client.Key
+	for _, key := range keys {
+		if teamID == "" || key.TeamID == teamID {
+			matches = append(matches, key)
+		}
+	}
+
+	if len(matches) == 0 {
 		return diag.Errorf("key with alias %s not found", keyAlias)
 	}
+	if len(matches) > 1 {
+		return diag.Errorf("found %d keys with alias %s; set team_id to pick one", len(matches), keyAlias)
+	}
+	key := matches[0]
 
-	d.SetId(key.KeyAlias)
+	d.SetId(key.Token)
 	d.Set("team_id", key.TeamID)
 	d.Set("models", key.Models)
 	d.Set("max_budget", key.MaxBudget)
 	d.Set("expires_at", key.ExpiresAt)
+	d.Set("tags", key.Tags)
+	d.Set("blocked", key.Blocked)
+	d.Set("user_id", key.UserID)
+	d.Set("soft_budget", key.SoftBudget)
+	d.Set("budget_duration", key.BudgetDuration)
+	d.Set("tpm_limit", key.TPMLimit)
+	d.Set("rpm_limit", key.RPMLimit)
+	d.Set("max_parallel_requests", key.MaxParallelRequests)
+	if err := flattenMetadata(d, key.Metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("aliases", key.Aliases)
+	d.Set("permissions", key.Permissions)
+	d.Set("allowed_cache_controls", key.AllowedCacheControls)
+	d.Set("guardrails", key.Guardrails)
+	d.Set("enforced_params", key.EnforcedParams)
+	d.Set("allowed_routes", key.AllowedRoutes)
+	d.Set("token", key.Token)
+	d.Set("spend", key.Spend)
+	d.Set("budget_reset_at", key.BudgetResetAt)
+	d.Set("created_at", key.CreatedAt)
+	d.Set("last_active", key.LastActive)
+
+	config := make(map[string]string, len(key.Config))
+	for k, v := range key.Config {
+		config[k] = fmt.Sprint(v)
+	}
+	d.Set("config", config)
+
+	modelMaxBudget := make([]interface{}, 0, len(key.ModelMaxBudget))
+	for model, b := range key.ModelMaxBudget {
+		modelMaxBudget = append(modelMaxBudget, map[string]interface{}{
+			"model":           model,
+			"max_budget":      b.MaxBudget,
+			"budget_duration": b.BudgetDuration,
+			"tpm_limit":       b.TPMLimit,
+			"rpm_limit":       b.RPMLimit,
+		})
+	}
+	d.Set("model_max_budget", modelMaxBudget)
+	d.Set("model_rpm_limit", resources.FlattenModelLimits(key.ModelRPMLimit))
+	d.Set("model_tpm_limit", resources.FlattenModelLimits(key.ModelTPMLimit))
 
 	return nil
 }
@@ -16,7 +16,93 @@ func DataSourceModel() *schema.Resource {
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The name of the model",
+				Description: "The public model name",
+			},
+			"model_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The deployment's `model_info.id`. Set it to pick one of several deployments that share `name`",
+			},
+			"model_info": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Routing and reporting metadata of the deployment",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"base_model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"team_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"team_public_model_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supports_vision": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_function_calling": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_parallel_function_calling": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"supports_response_schema": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the model was added to the proxy",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the model was last updated",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Who added the model",
+			},
+			"db_model": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the model is stored in the proxy database, rather than in its config file",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Priority of this deployment among those sharing `name`",
+			},
+			"weight": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Relative share of traffic this deployment receives",
 			},
 			"model_provider": {
 				Type:        schema.TypeString,
@@ -33,11 +119,116 @@ func DataSourceModel() *schema.Resource {
 				Computed:    true,
 				Description: "The base URL for API calls",
 			},
+			"litellm_credential_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the credential the model authenticates with",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API version to call, e.g. '2024-02-01' for Azure OpenAI",
+			},
+			"rpm": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Requests per minute this deployment can handle, used for load balancing",
+			},
+			"tpm": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Tokens per minute this deployment can handle, used for load balancing",
+			},
+			"timeout": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Request timeout in seconds",
+			},
+			"stream_timeout": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Timeout in seconds for streaming requests",
+			},
+			"max_retries": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of times a failed request is retried",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization ID sent to the provider (OpenAI)",
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Provider region the deployment runs in",
+			},
+			"input_cost_per_token": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Custom cost in USD per input token",
+			},
+			"output_cost_per_token": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Custom cost in USD per output token",
+			},
+			"input_cost_per_second": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Custom cost in USD per second of input, for deployments billed by time",
+			},
+			"output_cost_per_second": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Custom cost in USD per second of output, for deployments billed by time",
+			},
+			"input_cost_per_pixel": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Custom cost in USD per input pixel, for image models",
+			},
+			"output_cost_per_pixel": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Custom cost in USD per output pixel, for image models",
+			},
+			"max_budget": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Maximum spend in USD on this deployment",
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "How often spend on this deployment is reset (e.g. '30d')",
+			},
+			"use_in_pass_through": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Use this deployment's credentials for pass-through endpoints",
+			},
+			"merge_reasoning_content_in_choices": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Merge reasoning content into the response choices",
+			},
+			"max_file_size_mb": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Maximum size in MB of files sent to this deployment",
+			},
 			"metadata": {
 				Type:        schema.TypeMap,
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "Additional metadata for the model",
+				Description: "Additional metadata for the model. Only string values are included",
+			},
+			"metadata_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Additional metadata for the model as a JSON object, including non-string values",
 			},
 		},
 	}
@@ -48,20 +239,76 @@ func dataSourceModelRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	name := d.Get("name").(string)
 
-	model, err := c.GetModel(name)
+	modelID := d.Get("model_id").(string)
+
+	models, err := c.ListModels()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if model == nil {
+	var matches []client.Model
+	for _, model := range models {
+		if model.Name == name && (modelID == "" || model.ModelInfo.ID == modelID) {
+			matches = append(matches, model)
+		}
+	}
+
+	if len(matches) == 0 {
 		return diag.Errorf("model %s not found", name)
 	}
+	if len(matches) > 1 {
+		return diag.Errorf("found %d deployments named %s; set model_id to pick one", len(matches), name)
+	}
+	model := matches[0]
 
-	d.SetId(model.Name)
+	d.SetId(model.ModelInfo.ID)
+	d.Set("model_id", model.ModelInfo.ID)
+	d.Set("order", model.Order)
+	d.Set("created_at", model.ModelInfo.CreatedAt)
+	d.Set("updated_at", model.ModelInfo.UpdatedAt)
+	d.Set("created_by", model.ModelInfo.CreatedBy)
+	d.Set("db_model", model.ModelInfo.DBModel)
+	d.Set("model_info", []interface{}{
+		map[string]interface{}{
+			"mode":                               model.ModelInfo.Mode,
+			"base_model":                         model.ModelInfo.BaseModel,
+			"tier":                               model.ModelInfo.Tier,
+			"access_groups":                      model.ModelInfo.AccessGroups,
+			"team_id":                            model.ModelInfo.TeamID,
+			"team_public_model_name":             model.ModelInfo.TeamPublicModelName,
+			"supports_vision":                    model.ModelInfo.SupportsVision,
+			"supports_function_calling":          model.ModelInfo.SupportsFunctionCalling,
+			"supports_parallel_function_calling": model.ModelInfo.SupportsParallelFunctionCalling,
+			"supports_response_schema":           model.ModelInfo.SupportsResponseSchema,
+		},
+	})
+	d.Set("weight", model.Weight)
 	d.Set("model_provider", model.ModelProvider)
 	d.Set("model_name", model.ModelName)
 	d.Set("api_base", model.APIBase)
-	d.Set("metadata", model.Metadata)
+	d.Set("litellm_credential_name", model.LiteLLMCredentialName)
+	if err := flattenMetadata(d, model.Metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("api_version", model.APIVersion)
+	d.Set("rpm", model.RPM)
+	d.Set("tpm", model.TPM)
+	d.Set("timeout", model.Timeout)
+	d.Set("stream_timeout", model.StreamTimeout)
+	d.Set("max_retries", model.MaxRetries)
+	d.Set("organization", model.Organization)
+	d.Set("region_name", model.RegionName)
+	d.Set("input_cost_per_token", model.InputCostPerToken)
+	d.Set("output_cost_per_token", model.OutputCostPerToken)
+	d.Set("input_cost_per_second", model.InputCostPerSecond)
+	d.Set("output_cost_per_second", model.OutputCostPerSecond)
+	d.Set("input_cost_per_pixel", model.InputCostPerPixel)
+	d.Set("output_cost_per_pixel", model.OutputCostPerPixel)
+	d.Set("max_budget", model.MaxBudget)
+	d.Set("budget_duration", model.BudgetDuration)
+	d.Set("use_in_pass_through", model.UseInPassThrough)
+	d.Set("merge_reasoning_content_in_choices", model.MergeReasoningContentInChoices)
+	d.Set("max_file_size_mb", model.MaxFileSizeMB)
 
 	return nil
 }
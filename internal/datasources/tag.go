@@ -0,0 +1,82 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+func DataSourceTag() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceTagRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the tag",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of what the tag represents",
+			},
+			"models": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "List of models requests with this tag are routed to",
+			},
+			"model_info": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional model information for the tag",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp of the tag",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp of the tag",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User that created the tag",
+			},
+		},
+	}
+}
+
+func dataSourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	name := d.Get("name").(string)
+
+	tag, err := c.GetTag(name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tag == nil {
+		return diag.Errorf("tag %s not found", name)
+	}
+
+	d.SetId(tag.Name)
+	d.Set("description", tag.Description)
+	d.Set("models", tag.Models)
+	d.Set("model_info", tag.ModelInfo)
+	d.Set("created_at", tag.CreatedAt)
+	d.Set("updated_at", tag.UpdatedAt)
+	d.Set("created_by", tag.CreatedBy)
+
+	return nil
+}
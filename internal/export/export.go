@@ -0,0 +1,274 @@
+// Package export writes Terraform configuration for the objects on an
+// existing LiteLLM proxy, with import blocks that adopt them into state.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const envReferencePrefix = "os.environ/"
+
+const variablesFile = "variables.tf"
+
+// Export enumerates the proxy behind c and writes one .tf file per resource
+// type to dir. Each resource follows the import block that adopts it. Secrets
+// the proxy reports, which it masks, are replaced with references to
+// sensitive variables declared in variables.tf. Export returns the names of
+// the files it wrote; resource types with nothing to export are skipped.
+func Export(c *client.Client, dir string) ([]string, error) {
+	e := &exporter{
+		c:         c,
+		names:     make(map[string]bool),
+		variables: hclwrite.NewEmptyFile(),
+	}
+
+	steps := []struct {
+		file   string
+		export func(*hclwrite.Body) error
+	}{
+		{"models.tf", e.models},
+		{"keys.tf", e.keys},
+		{"credentials.tf", e.credentials},
+		{"customers.tf", e.customers},
+		{"tags.tf", e.tags},
+		{"pass_through_endpoints.tf", e.passThroughEndpoints},
+		{"allowed_ips.tf", e.allowedIPs},
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	var written []string
+	for _, step := range steps {
+		f := hclwrite.NewEmptyFile()
+		if err := step.export(f.Body()); err != nil {
+			return written, fmt.Errorf("failed to export %s: %w", strings.TrimSuffix(step.file, ".tf"), err)
+		}
+		if len(f.Body().Blocks()) == 0 {
+			continue
+		}
+		if err := writeFile(dir, step.file, f); err != nil {
+			return written, err
+		}
+		written = append(written, step.file)
+	}
+
+	if len(e.variables.Body().Blocks()) > 0 {
+		if err := writeFile(dir, variablesFile, e.variables); err != nil {
+			return written, err
+		}
+		written = append(written, variablesFile)
+	}
+
+	return written, nil
+}
+
+func writeFile(dir, name string, f *hclwrite.File) error {
+	return os.WriteFile(filepath.Join(dir, name), hclwrite.Format(f.Bytes()), 0o644)
+}
+
+type exporter struct {
+	c *client.Client
+
+	// names holds the resource and variable names in use, keyed by
+	// "<type>.<name>".
+	names     map[string]bool
+	variables *hclwrite.File
+}
+
+// resource appends an import block and an empty resource block for the
+// object with the given import ID, and returns the resource's name and body.
+func (e *exporter) resource(body *hclwrite.Body, resourceType, label, importID string) (string, *hclwrite.Body) {
+	name := e.uniqueName(resourceType, label)
+
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	imp := body.AppendNewBlock("import", nil).Body()
+	imp.SetAttributeTraversal("to", hcl.Traversal{
+		hcl.TraverseRoot{Name: resourceType},
+		hcl.TraverseAttr{Name: name},
+	})
+	imp.SetAttributeValue("id", cty.StringVal(importID))
+	body.AppendNewline()
+
+	return name, body.AppendNewBlock("resource", []string{resourceType, name}).Body()
+}
+
+// variable declares a sensitive string variable and returns a reference to
+// it.
+func (e *exporter) variable(label, description string) hclwrite.Tokens {
+	name := e.uniqueName("var", label)
+
+	body := e.variables.Body()
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	v := body.AppendNewBlock("variable", []string{name}).Body()
+	v.SetAttributeValue("description", cty.StringVal(description))
+	v.SetAttributeRaw("type", hclwrite.TokensForIdentifier("string"))
+	v.SetAttributeValue("sensitive", cty.True)
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+// setSecret sets field to a new variable, or field_env if the proxy reports
+// an os.environ/ reference rather than a masked value.
+func (e *exporter) setSecret(body *hclwrite.Body, owner, field, value string) {
+	if value == "" {
+		return
+	}
+	if strings.HasPrefix(value, envReferencePrefix) {
+		setString(body, field+"_env", strings.TrimPrefix(value, envReferencePrefix))
+		return
+	}
+	body.SetAttributeRaw(field, e.variable(owner+"_"+field, fmt.Sprintf("%s for %s", field, owner)))
+}
+
+// setSecretMap sets field to an object with a new variable for each value.
+func (e *exporter) setSecretMap(body *hclwrite.Body, owner, field string, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+
+	attrs := make([]hclwrite.ObjectAttrTokens, 0, len(values))
+	for _, k := range sortedKeys(values) {
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{
+			Name:  objectKey(k),
+			Value: e.variable(owner+"_"+k, fmt.Sprintf("%s.%s for %s", field, k, owner)),
+		})
+	}
+	body.SetAttributeRaw(field, hclwrite.TokensForObject(attrs))
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// uniqueName turns label into a Terraform identifier that is not yet used
+// within scope.
+func (e *exporter) uniqueName(scope, label string) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	name := base
+	for i := 2; e.names[scope+"."+name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	e.names[scope+"."+name] = true
+
+	return name
+}
+
+// appendComment appends a line comment to body.
+func appendComment(body *hclwrite.Body, text string) {
+	body.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte("# " + text + "\n")},
+	})
+}
+
+func objectKey(k string) hclwrite.Tokens {
+	if hclsyntax.ValidIdentifier(k) {
+		return hclwrite.TokensForIdentifier(k)
+	}
+	return hclwrite.TokensForValue(cty.StringVal(k))
+}
+
+func setString(body *hclwrite.Body, name, v string) {
+	if v != "" {
+		body.SetAttributeValue(name, cty.StringVal(v))
+	}
+}
+
+func setInt(body *hclwrite.Body, name string, v int) {
+	if v != 0 {
+		body.SetAttributeValue(name, cty.NumberIntVal(int64(v)))
+	}
+}
+
+func setFloat(body *hclwrite.Body, name string, v float64) {
+	if v != 0 {
+		body.SetAttributeValue(name, cty.NumberFloatVal(v))
+	}
+}
+
+func setBool(body *hclwrite.Body, name string, v bool) {
+	if v {
+		body.SetAttributeValue(name, cty.True)
+	}
+}
+
+func setStrings(body *hclwrite.Body, name string, v []string) {
+	if len(v) == 0 {
+		return
+	}
+	vals := make([]cty.Value, len(v))
+	for i, s := range v {
+		vals[i] = cty.StringVal(s)
+	}
+	body.SetAttributeValue(name, cty.ListVal(vals))
+}
+
+func setStringMap(body *hclwrite.Body, name string, v map[string]string) {
+	if len(v) == 0 {
+		return
+	}
+	vals := make(map[string]cty.Value, len(v))
+	for k, s := range v {
+		vals[k] = cty.StringVal(s)
+	}
+	body.SetAttributeValue(name, cty.MapVal(vals))
+}
+
+// setMetadata sets metadata when every value is a string, and metadata_json
+// otherwise.
+func setMetadata(body *hclwrite.Body, metadata map[string]interface{}) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	strs := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		s, ok := v.(string)
+		if !ok {
+			encoded, err := json.Marshal(metadata)
+			if err != nil {
+				return err
+			}
+			setString(body, "metadata_json", string(encoded))
+			return nil
+		}
+		strs[k] = s
+	}
+	setStringMap(body, "metadata", strs)
+
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,364 @@
+package export
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// fakeProxy serves canned responses for the list endpoints Export calls.
+func fakeProxy(t *testing.T) *httptest.Server {
+	responses := map[string]interface{}{
+		"/model/info": map[string]interface{}{
+			"data": []interface{}{
+				map[string]interface{}{
+					"model_name": "gpt-4o",
+					"litellm_params": map[string]interface{}{
+						"model":   "openai/gpt-4o",
+						"api_key": "sk-p********abcd",
+						"rpm":     100,
+						"metadata": map[string]interface{}{
+							"owners":      []string{"alice"},
+							"cost_center": 4200,
+						},
+					},
+					"model_info": map[string]interface{}{"id": "model-1", "mode": "chat"},
+				},
+				// A second deployment of the same public name
+				map[string]interface{}{
+					"model_name": "gpt-4o",
+					"litellm_params": map[string]interface{}{
+						"model":    "azure/gpt-4o",
+						"api_key":  "os.environ/AZURE_API_KEY",
+						"api_base": "https://eastus.example.com",
+					},
+					"model_info": map[string]interface{}{"id": "model-2"},
+				},
+				map[string]interface{}{
+					"model_name": "claude",
+					"litellm_params": map[string]interface{}{
+						"model":                 "bedrock/anthropic.claude-3-sonnet-20240229-v1:0",
+						"aws_region_name":       "us-east-1",
+						"aws_access_key_id":     "AKIA********WXYZ",
+						"aws_secret_access_key": "os.environ/AWS_SECRET_ACCESS_KEY",
+					},
+					"model_info": map[string]interface{}{"id": "model-3"},
+				},
+				// Defined in the proxy's config file
+				map[string]interface{}{
+					"model_name":     "config-model",
+					"litellm_params": map[string]interface{}{"model": "openai/gpt-4o-mini"},
+				},
+			},
+		},
+		"/key/list": map[string]interface{}{
+			"keys": []interface{}{
+				map[string]interface{}{
+					"token":     "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+					"key_alias": "search-service",
+					"team_id":   "search",
+					"models":    []string{"gpt-4o"},
+					"metadata":  map[string]string{"owner": "search"},
+					"model_rpm_limit": map[string]int{
+						"gpt-4o": 10,
+					},
+				},
+				map[string]interface{}{
+					"token": "fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210",
+				},
+				// A personal key, which litellm_key can't manage
+				map[string]interface{}{
+					"token":     "00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff",
+					"key_alias": "alice-laptop",
+					"user_id":   "alice",
+				},
+			},
+			"total_pages": 1,
+		},
+		"/credentials": map[string]interface{}{
+			"credentials": []interface{}{
+				map[string]interface{}{
+					"credential_name":   "openai-shared",
+					"credential_values": map[string]string{"api_key": "sk-p********abcd"},
+					"credential_info":   map[string]string{"custom_llm_provider": "openai"},
+				},
+			},
+		},
+		"/customer/list": []interface{}{
+			// Inline limits, which the proxy also stores as a budget
+			map[string]interface{}{
+				"user_id": "acme",
+				"litellm_budget_table": map[string]interface{}{
+					"budget_id":  "budget-acme",
+					"max_budget": 100,
+					"rpm_limit":  20,
+				},
+			},
+			// Two customers on a shared budget
+			map[string]interface{}{
+				"user_id": "globex",
+				"litellm_budget_table": map[string]interface{}{
+					"budget_id":  "budget-shared",
+					"max_budget": 500,
+				},
+			},
+			map[string]interface{}{
+				"user_id": "initech",
+				"litellm_budget_table": map[string]interface{}{
+					"budget_id":  "budget-shared",
+					"max_budget": 500,
+				},
+			},
+		},
+		"/tag/list":                     []interface{}{},
+		"/config/pass_through_endpoint": map[string]interface{}{"endpoints": []interface{}{}},
+		"/config/field/info": map[string]interface{}{
+			"field_value": []string{"10.0.0.0/8"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestExport(t *testing.T) {
+	server := fakeProxy(t)
+	dir := t.TempDir()
+
+	files, err := Export(client.NewClient("sk-admin", server.URL), dir)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	want := []string{"models.tf", "keys.tf", "credentials.tf", "customers.tf", "allowed_ips.tf", "variables.tf"}
+	if !reflect.DeepEqual(files, want) {
+		t.Fatalf("Export() wrote %v, want %v", files, want)
+	}
+
+	contents := make(map[string]string)
+	for _, name := range files {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		contents[name] = string(b)
+
+		if _, diags := hclsyntax.ParseConfig(b, name, hcl.InitialPos); diags.HasErrors() {
+			t.Errorf("%s is not valid HCL: %s\n%s", name, diags.Error(), b)
+		}
+		if strings.Contains(string(b), "********") {
+			t.Errorf("%s contains a masked secret:\n%s", name, b)
+		}
+	}
+
+	for file, snippets := range map[string][]string{
+		"models.tf": {
+			"to = litellm_model.gpt_4o\n",
+			`id = "model-1"`,
+			`resource "litellm_model" "gpt_4o" {`,
+			"api_key        = var.model_gpt_4o_api_key",
+			`metadata_json  = "{\"cost_center\":4200,\"owners\":[\"alice\"]}"`,
+			"to = litellm_model.gpt_4o_2\n",
+			`api_key_env    = "AZURE_API_KEY"`,
+			"aws_access_key_id         = var.model_claude_aws_access_key_id",
+			`aws_secret_access_key_env = "AWS_SECRET_ACCESS_KEY"`,
+			`mode = "chat"`,
+		},
+		"keys.tf": {
+			"to = litellm_key.search_service\n",
+			`id = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"`,
+			`key_alias = "search-service"`,
+			"model_rpm_limit {",
+			"# Skipped keys without a team, which litellm_key can't manage: alice-laptop",
+		},
+		"customers.tf": {
+			`user_id    = "acme"`,
+			"max_budget = 100",
+			"rpm_limit  = 20",
+			`budget_id = "budget-shared"`,
+		},
+		"credentials.tf": {
+			"api_key = var.credential_openai_shared_api_key",
+			`custom_llm_provider = "openai"`,
+		},
+		"allowed_ips.tf": {
+			"to = litellm_allowed_ip.ip_10_0_0_0_8\n",
+			`ip = "10.0.0.0/8"`,
+		},
+		"variables.tf": {
+			`variable "model_gpt_4o_api_key" {`,
+			`variable "model_claude_aws_access_key_id" {`,
+			`variable "credential_openai_shared_api_key" {`,
+			"sensitive   = true",
+		},
+	} {
+		for _, snippet := range snippets {
+			if !strings.Contains(contents[file], snippet) {
+				t.Errorf("%s does not contain %q:\n%s", file, snippet, contents[file])
+			}
+		}
+	}
+
+	if strings.Contains(contents["models.tf"], "config-model") {
+		t.Errorf("models.tf includes a model from the proxy's config file:\n%s", contents["models.tf"])
+	}
+	if strings.Count(contents["keys.tf"], "resource \"litellm_key\"") != 1 {
+		t.Errorf("keys.tf should only include the key with an alias and a team:\n%s", contents["keys.tf"])
+	}
+	if strings.Contains(contents["customers.tf"], "budget-acme") {
+		t.Errorf("customers.tf sets budget_id for a customer with inline limits:\n%s", contents["customers.tf"])
+	}
+
+	validateConfig(t, dir, files)
+}
+
+func TestUniqueName(t *testing.T) {
+	e := &exporter{names: make(map[string]bool)}
+
+	cases := []struct {
+		label, want string
+	}{
+		{"gpt-4o", "gpt_4o"},
+		{"gpt-4o", "gpt_4o_2"},
+		{"GPT 4o", "gpt_4o_3"},
+		{"/vendor-rerank", "vendor_rerank"},
+		{"3d-model", "_3d_model"},
+		{"---", "unnamed"},
+	}
+	for _, tc := range cases {
+		if got := e.uniqueName("litellm_model", tc.label); got != tc.want {
+			t.Errorf("uniqueName(%q) = %q, want %q", tc.label, got, tc.want)
+		}
+	}
+
+	if got := e.uniqueName("litellm_key", "gpt-4o"); got != "gpt_4o" {
+		t.Errorf("names should be unique per resource type, got %q", got)
+	}
+}
+
+// validateConfig checks the generated configuration against the provider's
+// schema, with the same validation Terraform runs on plan, and checks that
+// every import block adopts a resource in the configuration. Variables are
+// given a placeholder value.
+func validateConfig(t *testing.T, dir string, files []string) {
+	t.Helper()
+
+	var bodies []*hclsyntax.Body
+	variables := make(map[string]cty.Value)
+	for _, name := range files {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, diags := hclsyntax.ParseConfig(b, name, hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("%s is not valid HCL: %s", name, diags.Error())
+		}
+		body := f.Body.(*hclsyntax.Body)
+		for _, block := range body.Blocks {
+			if block.Type == "variable" {
+				variables[block.Labels[0]] = cty.StringVal("placeholder")
+			}
+		}
+		bodies = append(bodies, body)
+	}
+	ctx := &hcl.EvalContext{Variables: map[string]cty.Value{"var": cty.ObjectVal(variables)}}
+
+	resources := provider.New().ResourcesMap
+	declared := make(map[string]bool)
+	var imports []string
+	for _, body := range bodies {
+		for _, block := range body.Blocks {
+			switch block.Type {
+			case "resource":
+				address := strings.Join(block.Labels, ".")
+				declared[address] = true
+
+				r, ok := resources[block.Labels[0]]
+				if !ok {
+					t.Errorf("%s: unknown resource type", address)
+					continue
+				}
+				raw, err := blockValues(block.Body, ctx)
+				if err != nil {
+					t.Errorf("%s: %v", address, err)
+					continue
+				}
+				if diags := r.Validate(terraform.NewResourceConfigRaw(raw)); diags.HasError() {
+					for _, d := range diags {
+						t.Errorf("%s does not match the provider schema: %s: %s", address, d.Summary, d.Detail)
+					}
+				}
+			case "import":
+				traversal, diags := hcl.AbsTraversalForExpr(block.Body.Attributes["to"].Expr)
+				if diags.HasErrors() || len(traversal) != 2 {
+					t.Errorf("import block with an invalid to: %s", diags.Error())
+					continue
+				}
+				imports = append(imports, traversal.RootName()+"."+traversal[1].(hcl.TraverseAttr).Name)
+			}
+		}
+	}
+
+	for _, address := range imports {
+		if !declared[address] {
+			t.Errorf("import block for %s, which is not declared", address)
+		}
+	}
+}
+
+// blockValues evaluates a block body into the raw form of a resource
+// configuration, with nested blocks as lists.
+func blockValues(body *hclsyntax.Body, ctx *hcl.EvalContext) (map[string]interface{}, error) {
+	raw := make(map[string]interface{})
+	for name, attr := range body.Attributes {
+		v, diags := attr.Expr.Value(ctx)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		encoded, err := ctyjson.Marshal(v, v.Type())
+		if err != nil {
+			return nil, err
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			return nil, err
+		}
+		raw[name] = decoded
+	}
+	for _, block := range body.Blocks {
+		nested, err := blockValues(block.Body, ctx)
+		if err != nil {
+			return nil, err
+		}
+		list, _ := raw[block.Type].([]interface{})
+		raw[block.Type] = append(list, nested)
+	}
+	return raw, nil
+}
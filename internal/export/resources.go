@@ -0,0 +1,329 @@
+package export
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func (e *exporter) models(body *hclwrite.Body) error {
+	models, err := e.c.ListModels()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+
+	for _, m := range models {
+		if m.ModelInfo.ID == "" {
+			// Models from the proxy's config file have no database ID and
+			// can't be managed through the API.
+			continue
+		}
+
+		name, r := e.resource(body, "litellm_model", m.Name, m.ModelInfo.ID)
+		owner := "model_" + name
+
+		setString(r, "name", m.Name)
+		setString(r, "model_provider", m.ModelProvider)
+		setString(r, "model_name", m.ModelName)
+		setString(r, "api_base", m.APIBase)
+		e.setSecret(r, owner, "api_key", m.APIKey)
+		setString(r, "litellm_credential_name", m.LiteLLMCredentialName)
+		setInt(r, "rpm", m.RPM)
+		setInt(r, "tpm", m.TPM)
+		setFloat(r, "timeout", m.Timeout)
+		setFloat(r, "stream_timeout", m.StreamTimeout)
+		setInt(r, "max_retries", m.MaxRetries)
+		setString(r, "organization", m.Organization)
+		setString(r, "region_name", m.RegionName)
+		setFloat(r, "input_cost_per_token", m.InputCostPerToken)
+		setFloat(r, "output_cost_per_token", m.OutputCostPerToken)
+		setFloat(r, "input_cost_per_second", m.InputCostPerSecond)
+		setFloat(r, "output_cost_per_second", m.OutputCostPerSecond)
+		setFloat(r, "input_cost_per_pixel", m.InputCostPerPixel)
+		setFloat(r, "output_cost_per_pixel", m.OutputCostPerPixel)
+		setFloat(r, "max_budget", m.MaxBudget)
+		setString(r, "budget_duration", m.BudgetDuration)
+		setBool(r, "use_in_pass_through", m.UseInPassThrough)
+		setBool(r, "merge_reasoning_content_in_choices", m.MergeReasoningContentInChoices)
+		setFloat(r, "max_file_size_mb", m.MaxFileSizeMB)
+		setInt(r, "order", m.Order)
+		setFloat(r, "weight", m.Weight)
+		if err := setMetadata(r, m.Metadata); err != nil {
+			return err
+		}
+
+		// api_version belongs in the azure block when there is one
+		azure := m.ModelProvider == "azure" && m.APIVersion != "" &&
+			(m.TenantID != "" || m.ClientID != "" || m.ClientSecret != "" || m.AzureADToken != "")
+		if !azure {
+			setString(r, "api_version", m.APIVersion)
+		}
+
+		if m.AWSRegionName != "" {
+			b := r.AppendNewBlock("aws", nil).Body()
+			setString(b, "aws_region_name", m.AWSRegionName)
+			setString(b, "aws_role_name", m.AWSRoleName)
+			setString(b, "aws_session_name", m.AWSSessionName)
+			e.setSecret(b, owner, "aws_access_key_id", m.AWSAccessKeyID)
+			e.setSecret(b, owner, "aws_secret_access_key", m.AWSSecretAccessKey)
+			e.setSecret(b, owner, "aws_session_token", m.AWSSessionToken)
+		}
+		if m.VertexProject != "" {
+			b := r.AppendNewBlock("vertex", nil).Body()
+			setString(b, "vertex_project", m.VertexProject)
+			setString(b, "vertex_location", m.VertexLocation)
+			e.setSecret(b, owner, "vertex_credentials", m.VertexCredentials)
+		}
+		if azure {
+			b := r.AppendNewBlock("azure", nil).Body()
+			setString(b, "api_version", m.APIVersion)
+			setString(b, "tenant_id", m.TenantID)
+			setString(b, "client_id", m.ClientID)
+			e.setSecret(b, owner, "azure_ad_token", m.AzureADToken)
+			e.setSecret(b, owner, "client_secret", m.ClientSecret)
+		}
+		if m.WatsonxRegionName != "" {
+			b := r.AppendNewBlock("watsonx", nil).Body()
+			setString(b, "watsonx_region_name", m.WatsonxRegionName)
+			setString(b, "project_id", m.ProjectID)
+		}
+
+		info := m.ModelInfo
+		if info.Mode != "" || info.BaseModel != "" || info.Tier != "" || len(info.AccessGroups) > 0 ||
+			info.TeamID != "" || info.TeamPublicModelName != "" || info.SupportsVision ||
+			info.SupportsFunctionCalling || info.SupportsParallelFunctionCalling || info.SupportsResponseSchema {
+			b := r.AppendNewBlock("model_info", nil).Body()
+			setString(b, "mode", info.Mode)
+			setString(b, "base_model", info.BaseModel)
+			setString(b, "tier", info.Tier)
+			setStrings(b, "access_groups", info.AccessGroups)
+			setString(b, "team_id", info.TeamID)
+			setString(b, "team_public_model_name", info.TeamPublicModelName)
+			setBool(b, "supports_vision", info.SupportsVision)
+			setBool(b, "supports_function_calling", info.SupportsFunctionCalling)
+			setBool(b, "supports_parallel_function_calling", info.SupportsParallelFunctionCalling)
+			setBool(b, "supports_response_schema", info.SupportsResponseSchema)
+		}
+	}
+
+	return nil
+}
+
+func (e *exporter) keys(body *hclwrite.Body) error {
+	keys, err := e.c.ListAllKeys()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].KeyAlias < keys[j].KeyAlias })
+
+	var teamless []string
+	for _, k := range keys {
+		// key_alias is required, and keys without one are mostly the admin
+		// UI's session keys.
+		if k.KeyAlias == "" || k.Token == "" {
+			continue
+		}
+		// team_id is required too, so personal keys can't be managed.
+		if k.TeamID == "" {
+			teamless = append(teamless, k.KeyAlias)
+			continue
+		}
+
+		_, r := e.resource(body, "litellm_key", k.KeyAlias, k.Token)
+
+		r.SetAttributeValue("key_alias", cty.StringVal(k.KeyAlias))
+		r.SetAttributeValue("team_id", cty.StringVal(k.TeamID))
+		setString(r, "user_id", k.UserID)
+		setStrings(r, "models", k.Models)
+		setFloat(r, "max_budget", k.MaxBudget)
+		setFloat(r, "soft_budget", k.SoftBudget)
+		setString(r, "budget_duration", k.BudgetDuration)
+		setString(r, "expires_at", k.ExpiresAt)
+		setInt(r, "tpm_limit", k.TPMLimit)
+		setInt(r, "rpm_limit", k.RPMLimit)
+		setInt(r, "max_parallel_requests", k.MaxParallelRequests)
+		setStrings(r, "tags", k.Tags)
+		setBool(r, "blocked", k.Blocked)
+		if err := setMetadata(r, k.Metadata); err != nil {
+			return err
+		}
+		setStringMap(r, "aliases", k.Aliases)
+
+		config := make(map[string]string, len(k.Config))
+		for name, v := range k.Config {
+			config[name] = fmt.Sprint(v)
+		}
+		setStringMap(r, "config", config)
+
+		if len(k.Permissions) > 0 {
+			permissions := make(map[string]cty.Value, len(k.Permissions))
+			for name, v := range k.Permissions {
+				permissions[name] = cty.BoolVal(v)
+			}
+			r.SetAttributeValue("permissions", cty.MapVal(permissions))
+		}
+
+		budgetModels := make([]string, 0, len(k.ModelMaxBudget))
+		for model := range k.ModelMaxBudget {
+			budgetModels = append(budgetModels, model)
+		}
+		sort.Strings(budgetModels)
+		for _, model := range budgetModels {
+			budget := k.ModelMaxBudget[model]
+			b := r.AppendNewBlock("model_max_budget", nil).Body()
+			setString(b, "model", model)
+			setFloat(b, "max_budget", budget.MaxBudget)
+			setString(b, "budget_duration", budget.BudgetDuration)
+			setInt(b, "tpm_limit", budget.TPMLimit)
+			setInt(b, "rpm_limit", budget.RPMLimit)
+		}
+		appendModelLimits(r, "model_rpm_limit", k.ModelRPMLimit)
+		appendModelLimits(r, "model_tpm_limit", k.ModelTPMLimit)
+
+		setStrings(r, "allowed_cache_controls", k.AllowedCacheControls)
+		setStrings(r, "guardrails", k.Guardrails)
+		setStrings(r, "enforced_params", k.EnforcedParams)
+		setStrings(r, "allowed_routes", k.AllowedRoutes)
+	}
+
+	if len(teamless) > 0 {
+		if len(body.Blocks()) > 0 {
+			body.AppendNewline()
+		}
+		appendComment(body, "Skipped keys without a team, which litellm_key can't manage: "+strings.Join(teamless, ", "))
+	}
+
+	return nil
+}
+
+func appendModelLimits(body *hclwrite.Body, name string, limits map[string]int) {
+	models := make([]string, 0, len(limits))
+	for model := range limits {
+		models = append(models, model)
+	}
+	sort.Strings(models)
+
+	for _, model := range models {
+		b := body.AppendNewBlock(name, nil).Body()
+		setString(b, "model", model)
+		b.SetAttributeValue("limit", cty.NumberIntVal(int64(limits[model])))
+	}
+}
+
+func (e *exporter) credentials(body *hclwrite.Body) error {
+	credentials, err := e.c.ListCredentials()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(credentials, func(i, j int) bool {
+		return credentials[i].CredentialName < credentials[j].CredentialName
+	})
+
+	for _, cred := range credentials {
+		name, r := e.resource(body, "litellm_credential", cred.CredentialName, cred.CredentialName)
+
+		setString(r, "credential_name", cred.CredentialName)
+		// Every value is masked by the proxy, so all of them become variables
+		e.setSecretMap(r, "credential_"+name, "credential_values", cred.CredentialValues)
+		setStringMap(r, "credential_info", cred.CredentialInfo)
+	}
+
+	return nil
+}
+
+func (e *exporter) customers(body *hclwrite.Body) error {
+	customers, err := e.c.ListCustomers()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(customers, func(i, j int) bool { return customers[i].UserID < customers[j].UserID })
+
+	// The proxy reports a budget_id for inline limits too, so only budgets
+	// that several customers use are exported as budget_id.
+	budgetUsers := make(map[string]int)
+	for _, cust := range customers {
+		if cust.BudgetID != "" {
+			budgetUsers[cust.BudgetID]++
+		}
+	}
+
+	for _, cust := range customers {
+		_, r := e.resource(body, "litellm_customer", cust.UserID, cust.UserID)
+
+		setString(r, "user_id", cust.UserID)
+		setString(r, "alias", cust.Alias)
+		setBool(r, "blocked", cust.Blocked)
+		setString(r, "allowed_model_region", cust.AllowedModelRegion)
+		setString(r, "default_model", cust.DefaultModel)
+		if budgetUsers[cust.BudgetID] > 1 {
+			setString(r, "budget_id", cust.BudgetID)
+			continue
+		}
+		setFloat(r, "max_budget", cust.MaxBudget)
+		setFloat(r, "soft_budget", cust.SoftBudget)
+		setString(r, "budget_duration", cust.BudgetDuration)
+		setInt(r, "tpm_limit", cust.TPMLimit)
+		setInt(r, "rpm_limit", cust.RPMLimit)
+		setInt(r, "max_parallel_requests", cust.MaxParallelRequests)
+	}
+
+	return nil
+}
+
+func (e *exporter) tags(body *hclwrite.Body) error {
+	tags, err := e.c.ListTags()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	for _, tag := range tags {
+		_, r := e.resource(body, "litellm_tag", tag.Name, tag.Name)
+
+		setString(r, "name", tag.Name)
+		setString(r, "description", tag.Description)
+		setStrings(r, "models", tag.Models)
+		setStringMap(r, "model_info", tag.ModelInfo)
+	}
+
+	return nil
+}
+
+func (e *exporter) passThroughEndpoints(body *hclwrite.Body) error {
+	endpoints, err := e.c.ListPassThroughEndpoints()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool { return endpoints[i].Path < endpoints[j].Path })
+
+	for _, endpoint := range endpoints {
+		name, r := e.resource(body, "litellm_pass_through_endpoint", endpoint.Path, endpoint.Path)
+
+		setString(r, "path", endpoint.Path)
+		setString(r, "target", endpoint.Target)
+		// Headers usually carry the vendor's credentials
+		e.setSecretMap(r, "pass_through_"+name, "headers", endpoint.Headers)
+	}
+
+	return nil
+}
+
+func (e *exporter) allowedIPs(body *hclwrite.Body) error {
+	ips, err := e.c.ListAllowedIPs()
+	if err != nil {
+		return err
+	}
+	sort.Strings(ips)
+
+	for _, ip := range ips {
+		_, r := e.resource(body, "litellm_allowed_ip", "ip_"+ip, ip)
+
+		setString(r, "ip", ip)
+	}
+
+	return nil
+}
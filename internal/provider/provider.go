@@ -2,9 +2,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/catalog"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/datasources"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/resources"
@@ -31,14 +33,34 @@ func New() *schema.Provider {
 				Default:     "https://api.litellm.io",
 				Description: "Base URL for the LiteLLM API.",
 			},
+			"refresh_provider_catalog": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Also accept the model providers reported by the proxy's `/public/providers` endpoint, in addition to the built-in catalog.",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"litellm_model": resources.ResourceModel(),
-			"litellm_key":   resources.ResourceKey(),
+			"litellm_model":                   resources.ResourceModel(),
+			"litellm_key":                     resources.ResourceKey(),
+			"litellm_customer":                resources.ResourceCustomer(),
+			"litellm_tag":                     resources.ResourceTag(),
+			"litellm_credential":              resources.ResourceCredential(),
+			"litellm_pass_through_endpoint":   resources.ResourcePassThroughEndpoint(),
+			"litellm_vector_store":            resources.ResourceVectorStore(),
+			"litellm_team_callback":           resources.ResourceTeamCallback(),
+			"litellm_allowed_ip":              resources.ResourceAllowedIP(),
+			"litellm_internal_user_settings":  resources.ResourceInternalUserSettings(),
+			"litellm_default_team_settings":   resources.ResourceDefaultTeamSettings(),
+			"litellm_team_model_assignment":   resources.ResourceTeamModelAssignment(),
+			"litellm_team_member_permissions": resources.ResourceTeamMemberPermissions(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"litellm_model": datasources.DataSourceModel(),
-			"litellm_key":   datasources.DataSourceKey(),
+			"litellm_model":       datasources.DataSourceModel(),
+			"litellm_key":         datasources.DataSourceKey(),
+			"litellm_customers":   datasources.DataSourceCustomers(),
+			"litellm_tag":         datasources.DataSourceTag(),
+			"litellm_allowed_ips": datasources.DataSourceAllowedIPs(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -55,5 +77,19 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 
 	client := client.NewClient(apiKey, endpoint)
 
-	return client, nil
+	var diags diag.Diagnostics
+	if d.Get("refresh_provider_catalog").(bool) {
+		providers, err := client.ListProviders()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Could not refresh the provider catalog",
+				Detail:   fmt.Sprintf("Falling back to the built-in provider catalog: %v", err),
+			})
+		} else {
+			catalog.Register(providers...)
+		}
+	}
+
+	return client, diags
 }
@@ -0,0 +1,78 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceAllowedIP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAllowedIPCreate,
+		ReadContext:   resourceAllowedIPRead,
+		DeleteContext: resourceAllowedIPDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "IP address or CIDR block allowed to call the proxy",
+				ValidateFunc: validation.IPOrCIDR,
+			},
+		},
+	}
+}
+
+func resourceAllowedIPCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	ip := d.Get("ip").(string)
+
+	if err := c.AddAllowedIP(ip); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(ip)
+
+	return resourceAllowedIPRead(ctx, d, m)
+}
+
+func resourceAllowedIPRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	ips, err := c.ListAllowedIPs()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, ip := range ips {
+		if ip == d.Id() {
+			d.Set("ip", ip)
+			return nil
+		}
+	}
+
+	// Removed from the allowlist outside Terraform
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAllowedIPDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteAllowedIP(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package resources_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceAllowedIP_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAllowedIPConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_allowed_ip.office", "ip", "203.0.113.0/24"),
+					resource.TestCheckResourceAttr(
+						"litellm_allowed_ip.bastion", "ip", "198.51.100.7"),
+					resource.TestCheckTypeSetElemAttr(
+						"data.litellm_allowed_ips.all", "ips.*", "203.0.113.0/24"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_allowed_ip.office",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccResourceAllowedIPConfig_invalid(),
+				ExpectError: regexp.MustCompile(`must be a valid IP address or CIDR block`),
+			},
+		},
+	})
+}
+
+func testAccResourceAllowedIPConfig_basic() string {
+	return `
+resource "litellm_allowed_ip" "office" {
+  ip = "203.0.113.0/24"
+}
+
+resource "litellm_allowed_ip" "bastion" {
+  ip = "198.51.100.7"
+}
+
+data "litellm_allowed_ips" "all" {
+  depends_on = [litellm_allowed_ip.office, litellm_allowed_ip.bastion]
+}
+`
+}
+
+func testAccResourceAllowedIPConfig_invalid() string {
+	return `
+resource "litellm_allowed_ip" "invalid" {
+  ip = "203.0.113.0/33"
+}
+`
+}
@@ -0,0 +1,167 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceCredential() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCredentialCreate,
+		ReadContext:   resourceCredentialRead,
+		UpdateContext: resourceCredentialUpdate,
+		DeleteContext: resourceCredentialDelete,
+		CustomizeDiff: resourceCredentialCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"credential_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name models use to reference this credential",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"credential_values": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Secret values of the credential (e.g. `api_key`, `api_base`)",
+			},
+			"credential_values_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Salted SHA-256 fingerprint of the credential values last sent. Cleared when the values on the proxy no longer match, so the next apply sends them again",
+			},
+			"credential_info": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Non-secret information about the credential (e.g. `custom_llm_provider`)",
+			},
+		},
+	}
+}
+
+func resourceCredentialCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	credential := expandCredential(d)
+	credential.CredentialName = d.Get("credential_name").(string)
+
+	if err := c.CreateCredential(credential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(credential.CredentialName)
+
+	if diags := setCredentialValuesFingerprint(d, credential); diags.HasError() {
+		return diags
+	}
+
+	return resourceCredentialRead(ctx, d, m)
+}
+
+func resourceCredentialRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	credential, err := c.GetCredential(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if credential == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("credential_name", credential.CredentialName)
+	d.Set("credential_info", credential.CredentialInfo)
+	// Don't set credential_values as the API only returns masked values, but
+	// clear the fingerprint if they no longer match so the next plan sends
+	// them again.
+	for k, v := range d.Get("credential_values").(map[string]interface{}) {
+		if !maskedValueMatches(credential.CredentialValues[k], v.(string)) {
+			d.Set("credential_values_fingerprint", "")
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceCredentialUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	credential := expandCredential(d)
+	credential.CredentialName = d.Id()
+
+	if err := c.UpdateCredential(credential); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := setCredentialValuesFingerprint(d, credential); diags.HasError() {
+		return diags
+	}
+
+	return resourceCredentialRead(ctx, d, m)
+}
+
+func resourceCredentialDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteCredential(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCredential(d *schema.ResourceData) *client.Credential {
+	credential := &client.Credential{
+		CredentialValues: make(map[string]string),
+		CredentialInfo:   make(map[string]string),
+	}
+
+	for k, v := range d.Get("credential_values").(map[string]interface{}) {
+		credential.CredentialValues[k] = v.(string)
+	}
+	for k, v := range d.Get("credential_info").(map[string]interface{}) {
+		credential.CredentialInfo[k] = v.(string)
+	}
+
+	return credential
+}
+
+func setCredentialValuesFingerprint(d *schema.ResourceData, credential *client.Credential) diag.Diagnostics {
+	fingerprint, err := updateSecretFingerprint(d.Get("credential_values_fingerprint").(string), secretMapValue(credential.CredentialValues))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("credential_values_fingerprint", fingerprint)
+	return nil
+}
+
+// resourceCredentialCustomizeDiff plans an update that re-sends the values
+// when they no longer match the fingerprint.
+func resourceCredentialCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("credential_values") {
+		return nil
+	}
+
+	values := expandStringMap(d.Get("credential_values").(map[string]interface{}))
+	if secretFingerprintMatches(d.Get("credential_values_fingerprint").(string), secretMapValue(values)) {
+		return nil
+	}
+
+	return d.SetNewComputed("credential_values_fingerprint")
+}
@@ -0,0 +1,63 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceCredential_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCredentialConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_credential.test", "credential_name", "openai-shared"),
+					resource.TestCheckResourceAttr(
+						"litellm_credential.test", "credential_info.custom_llm_provider", "openai"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.test", "litellm_credential_name", "openai-shared"),
+					resource.TestCheckResourceAttrSet(
+						"litellm_credential.test", "credential_values_fingerprint"),
+				),
+			},
+			// Unchanged values plan no changes
+			{
+				Config:   testAccResourceCredentialConfig_basic(),
+				PlanOnly: true,
+			},
+			// Import test
+			{
+				ResourceName:            "litellm_credential.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credential_values", "credential_values_fingerprint"},
+			},
+		},
+	})
+}
+
+func testAccResourceCredentialConfig_basic() string {
+	return `
+resource "litellm_credential" "test" {
+  credential_name = "openai-shared"
+  credential_values = {
+    api_key = "sk-test"
+  }
+  credential_info = {
+    custom_llm_provider = "openai"
+  }
+}
+
+resource "litellm_model" "test" {
+  name                    = "credential-model"
+  model_provider          = "openai"
+  model_name              = "gpt-4"
+  litellm_credential_name = litellm_credential.test.credential_name
+}
+`
+}
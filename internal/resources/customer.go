@@ -0,0 +1,277 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomerCreate,
+		ReadContext:   resourceCustomerRead,
+		UpdateContext: resourceCustomerUpdate,
+		DeleteContext: resourceCustomerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The end-user ID sent as the `user` param on requests to the proxy",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly alias for the customer",
+			},
+			"blocked": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether requests for this customer are rejected",
+			},
+			"allowed_model_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Require all requests for this customer to use models in this region ('eu' or 'us')",
+				ValidateFunc: validation.OneOf("eu", "us"),
+			},
+			"default_model": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Model to use when no equivalent model exists in the allowed region",
+			},
+			"budget_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "ID of an existing budget, e.g. one shared with other customers, to attach to the customer instead of setting its limits here",
+				ConflictsWith: []string{"max_budget", "soft_budget", "budget_duration", "tpm_limit", "rpm_limit", "max_parallel_requests"},
+			},
+			"max_budget": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum spend in USD before requests for this customer fail",
+				ValidateFunc:  validation.FloatGreaterThanOrEqual(0),
+				ConflictsWith: []string{"budget_id"},
+			},
+			"soft_budget": {
+				Type:          schema.TypeFloat,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Spend in USD that triggers alerting without rejecting requests",
+				ValidateFunc:  validation.FloatGreaterThanOrEqual(0),
+				ConflictsWith: []string{"budget_id"},
+			},
+			"budget_duration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "How often the budget resets (e.g. '30s', '30m', '30h', '30d')",
+				ConflictsWith: []string{"budget_id"},
+			},
+			"tpm_limit": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Tokens per minute allowed for this customer",
+				ConflictsWith: []string{"budget_id"},
+			},
+			"rpm_limit": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Requests per minute allowed for this customer",
+				ConflictsWith: []string{"budget_id"},
+			},
+			"max_parallel_requests": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				Description:   "Maximum concurrent requests allowed for this customer",
+				ConflictsWith: []string{"budget_id"},
+			},
+			"spend": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Total spend in USD recorded for this customer",
+			},
+		},
+	}
+}
+
+func resourceCustomerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	customer := &client.Customer{
+		UserID:              d.Get("user_id").(string),
+		Alias:               d.Get("alias").(string),
+		Blocked:             d.Get("blocked").(bool),
+		AllowedModelRegion:  d.Get("allowed_model_region").(string),
+		DefaultModel:        d.Get("default_model").(string),
+		BudgetID:            d.Get("budget_id").(string),
+		MaxBudget:           d.Get("max_budget").(float64),
+		SoftBudget:          d.Get("soft_budget").(float64),
+		BudgetDuration:      d.Get("budget_duration").(string),
+		TPMLimit:            d.Get("tpm_limit").(int),
+		RPMLimit:            d.Get("rpm_limit").(int),
+		MaxParallelRequests: d.Get("max_parallel_requests").(int),
+	}
+
+	if err := c.CreateCustomer(customer); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(customer.UserID)
+
+	return resourceCustomerRead(ctx, d, m)
+}
+
+func resourceCustomerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	customer, err := c.GetCustomer(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if customer == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("user_id", customer.UserID)
+	d.Set("alias", customer.Alias)
+	d.Set("blocked", customer.Blocked)
+	d.Set("allowed_model_region", customer.AllowedModelRegion)
+	d.Set("default_model", customer.DefaultModel)
+	d.Set("budget_id", customer.BudgetID)
+	d.Set("max_budget", customer.MaxBudget)
+	d.Set("soft_budget", customer.SoftBudget)
+	d.Set("budget_duration", customer.BudgetDuration)
+	d.Set("tpm_limit", customer.TPMLimit)
+	d.Set("rpm_limit", customer.RPMLimit)
+	d.Set("max_parallel_requests", customer.MaxParallelRequests)
+	d.Set("spend", customer.Spend)
+
+	return nil
+}
+
+func resourceCustomerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if d.HasChanges("alias", "allowed_model_region", "default_model", "budget_id", "max_budget") {
+		// /customer/update defaults blocked to false, so always send the
+		// desired value to avoid unblocking a customer as a side effect.
+		customer := &client.Customer{
+			UserID:             d.Id(),
+			Alias:              d.Get("alias").(string),
+			Blocked:            d.Get("blocked").(bool),
+			AllowedModelRegion: d.Get("allowed_model_region").(string),
+			DefaultModel:       d.Get("default_model").(string),
+		}
+
+		// Only send the budget settings that changed; resending them for a
+		// customer on a shared budget would rewrite that budget.
+		if d.HasChange("budget_id") {
+			customer.BudgetID = d.Get("budget_id").(string)
+		}
+		if d.HasChange("max_budget") {
+			customer.MaxBudget = d.Get("max_budget").(float64)
+		}
+
+		if err := c.UpdateCustomer(customer); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// /customer/update doesn't accept the other limits; they live on the
+	// customer's own budget.
+	if d.HasChanges("soft_budget", "budget_duration", "tpm_limit", "rpm_limit", "max_parallel_requests") {
+		if diags := resourceCustomerUpdateBudget(d, c); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("blocked") {
+		var err error
+		if d.Get("blocked").(bool) {
+			err = c.BlockCustomer(d.Id())
+		} else {
+			err = c.UnblockCustomer(d.Id())
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCustomerRead(ctx, d, m)
+}
+
+// resourceCustomerUpdateBudget writes the changed limits to the customer's
+// budget, first creating one for a customer that has none. The limits
+// conflict with budget_id, so the budget isn't shared with other customers.
+func resourceCustomerUpdateBudget(d *schema.ResourceData, c *client.Client) diag.Diagnostics {
+	budget := &client.BudgetTable{BudgetID: d.Get("budget_id").(string)}
+	if d.HasChange("soft_budget") {
+		budget.SoftBudget = d.Get("soft_budget").(float64)
+	}
+	if d.HasChange("budget_duration") {
+		budget.BudgetDuration = d.Get("budget_duration").(string)
+	}
+	if d.HasChange("tpm_limit") {
+		budget.TPMLimit = d.Get("tpm_limit").(int)
+	}
+	if d.HasChange("rpm_limit") {
+		budget.RPMLimit = d.Get("rpm_limit").(int)
+	}
+	if d.HasChange("max_parallel_requests") {
+		budget.MaxParallelRequests = d.Get("max_parallel_requests").(int)
+	}
+
+	if budget.BudgetID != "" {
+		if err := c.UpdateBudget(budget); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	budget.MaxBudget = d.Get("max_budget").(float64)
+	if err := c.CreateBudget(budget); err != nil {
+		return diag.FromErr(err)
+	}
+	err := c.UpdateCustomer(&client.Customer{
+		UserID:             d.Id(),
+		Alias:              d.Get("alias").(string),
+		Blocked:            d.Get("blocked").(bool),
+		AllowedModelRegion: d.Get("allowed_model_region").(string),
+		DefaultModel:       d.Get("default_model").(string),
+		BudgetID:           budget.BudgetID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCustomerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteCustomer(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
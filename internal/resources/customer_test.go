@@ -0,0 +1,262 @@
+package resources_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/resources"
+)
+
+func TestAccResourceCustomer_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomerConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "user_id", "test-customer"),
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "alias", "Test Customer"),
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "allowed_model_region", "eu"),
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "max_budget", "50"),
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "blocked", "false"),
+				),
+			},
+			// Test blocking
+			{
+				Config: testAccResourceCustomerConfig_blocked(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "blocked", "true"),
+					resource.TestCheckResourceAttr(
+						"litellm_customer.test", "max_budget", "50"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_customer.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCustomerConfig_basic() string {
+	return `
+resource "litellm_customer" "test" {
+  user_id              = "test-customer"
+  alias                = "Test Customer"
+  allowed_model_region = "eu"
+  max_budget           = 50
+}
+`
+}
+
+func testAccResourceCustomerConfig_blocked() string {
+	return `
+resource "litellm_customer" "test" {
+  user_id              = "test-customer"
+  alias                = "Test Customer"
+  allowed_model_region = "eu"
+  max_budget           = 50
+  blocked              = true
+}
+`
+}
+
+// customerProxy serves a customer and its budget, recording the updates it
+// receives.
+type customerProxy struct {
+	t        *testing.T
+	customer map[string]interface{}
+	budget   map[string]interface{}
+	requests []string
+}
+
+func (p *customerProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var body map[string]interface{}
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			p.t.Errorf("failed to decode request: %v", err)
+		}
+	}
+	p.requests = append(p.requests, r.URL.Path)
+
+	switch r.URL.Path {
+	case "/customer/info":
+		customer := map[string]interface{}{"litellm_budget_table": p.budget}
+		for k, v := range p.customer {
+			customer[k] = v
+		}
+		json.NewEncoder(w).Encode(customer)
+	case "/customer/update":
+		for k, v := range body {
+			switch k {
+			case "budget_id":
+				p.budget["budget_id"] = v
+			case "user_id", "alias", "blocked", "allowed_model_region", "default_model":
+				p.customer[k] = v
+			case "max_budget":
+				p.budget[k] = v
+			default:
+				p.t.Errorf("/customer/update doesn't accept %s", k)
+			}
+		}
+		w.Write([]byte("{}"))
+	case "/budget/update":
+		if body["budget_id"] != p.budget["budget_id"] {
+			p.t.Errorf("/budget/update for budget %v, want %v", body["budget_id"], p.budget["budget_id"])
+		}
+		for k, v := range body {
+			p.budget[k] = v
+		}
+		w.Write([]byte("{}"))
+	case "/budget/new":
+		body["budget_id"] = "budget-new"
+		p.budget = body
+		json.NewEncoder(w).Encode(body)
+	default:
+		p.t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		http.NotFound(w, r)
+	}
+}
+
+func TestResourceCustomer_limitsUpdateInPlace(t *testing.T) {
+	cases := []struct {
+		name      string
+		budget    map[string]interface{}
+		state     map[string]string
+		wantPaths []string
+		wantID    string
+	}{
+		{
+			name: "own budget",
+			budget: map[string]interface{}{
+				"budget_id": "budget-1",
+				"rpm_limit": 10,
+				"tpm_limit": 1000,
+			},
+			state: map[string]string{
+				"budget_id": "budget-1",
+				"rpm_limit": "10",
+				"tpm_limit": "1000",
+			},
+			wantPaths: []string{"/budget/update", "/customer/info"},
+			wantID:    "budget-1",
+		},
+		{
+			name:   "no budget yet",
+			budget: map[string]interface{}{},
+			state: map[string]string{
+				"rpm_limit": "0",
+				"tpm_limit": "0",
+			},
+			wantPaths: []string{"/budget/new", "/customer/update", "/customer/info"},
+			wantID:    "budget-new",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			proxy := &customerProxy{
+				t:        t,
+				customer: map[string]interface{}{"user_id": "limits-customer"},
+				budget:   tc.budget,
+			}
+			server := httptest.NewServer(proxy)
+			defer server.Close()
+
+			attributes := map[string]string{"id": "limits-customer", "user_id": "limits-customer"}
+			for k, v := range tc.state {
+				attributes[k] = v
+			}
+			state := &terraform.InstanceState{ID: "limits-customer", Attributes: attributes}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"user_id":   "limits-customer",
+				"rpm_limit": 20,
+				"tpm_limit": 1000,
+			})
+
+			r := resources.ResourceCustomer()
+			c := client.NewClient("sk-admin", server.URL)
+			diff, err := r.SimpleDiff(context.Background(), state, config, c)
+			if err != nil {
+				t.Fatalf("diff: %v", err)
+			}
+			if diff.RequiresNew() {
+				t.Fatal("changing rpm_limit should update the customer in place, not replace it")
+			}
+
+			newState, diags := r.Apply(context.Background(), state, diff, c)
+			if diags.HasError() {
+				t.Fatalf("apply: %v", diags)
+			}
+
+			if !reflect.DeepEqual(proxy.requests, tc.wantPaths) {
+				t.Errorf("requests = %v, want %v", proxy.requests, tc.wantPaths)
+			}
+			if got := proxy.budget["rpm_limit"]; got != 20.0 {
+				t.Errorf("proxy rpm_limit = %v, want 20", got)
+			}
+			if got := newState.Attributes["rpm_limit"]; got != "20" {
+				t.Errorf("rpm_limit in state = %s, want 20", got)
+			}
+			if got := newState.Attributes["budget_id"]; got != tc.wantID {
+				t.Errorf("budget_id in state = %s, want %s", got, tc.wantID)
+			}
+		})
+	}
+}
+
+func TestAccResourceCustomer_limits(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomerConfig_limits(10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_customer.limits", "rpm_limit", "10"),
+				),
+			},
+			// Rate limits update in place
+			{
+				Config: testAccResourceCustomerConfig_limits(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_customer.limits", "rpm_limit", "20"),
+					resource.TestCheckResourceAttr(
+						"litellm_customer.limits", "tpm_limit", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceCustomerConfig_limits(rpm int) string {
+	return fmt.Sprintf(`
+resource "litellm_customer" "limits" {
+  user_id         = "limits-customer"
+  soft_budget     = 5
+  budget_duration = "30d"
+  tpm_limit       = 1000
+  rpm_limit       = %d
+}
+`, rpm)
+}
@@ -0,0 +1,42 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+var defaultTeamSettings = proxySettings{
+	name:        "default_team_settings",
+	description: "default team settings",
+	attributes: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"models": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Default list of models new teams can access",
+			},
+			"max_budget": {
+				Type:         schema.TypeFloat,
+				Description:  "Default maximum budget in USD for new teams",
+				ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Description: "Default budget reset period for new teams (e.g. '30d')",
+			},
+			"tpm_limit": {
+				Type:        schema.TypeInt,
+				Description: "Default tokens per minute limit for new teams",
+			},
+			"rpm_limit": {
+				Type:        schema.TypeInt,
+				Description: "Default requests per minute limit for new teams",
+			},
+		}
+	},
+}
+
+// ResourceDefaultTeamSettings manages the proxy-wide defaults for new teams.
+func ResourceDefaultTeamSettings() *schema.Resource {
+	return defaultTeamSettings.resource()
+}
@@ -0,0 +1,76 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceDefaultTeamSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefaultTeamSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_default_team_settings.test", "models.#", "1"),
+					resource.TestCheckResourceAttr(
+						"litellm_default_team_settings.test", "tpm_limit", "100000"),
+					resource.TestCheckResourceAttr(
+						"litellm_default_team_settings.test", "restore_on_delete", "false"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:            "litellm_default_team_settings.test",
+				ImportState:             true,
+				ImportStateId:           "default_team_settings",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_on_delete", "previous_settings"},
+			},
+		},
+	})
+}
+
+func TestAccResourceDefaultTeamSettings_restoreOnDelete(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		CheckDestroy: testAccCheckSettingsRestored(
+			"litellm_default_team_settings.test", "default_team_settings"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceDefaultTeamSettingsConfig_restoreOnDelete(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_default_team_settings.test", "rpm_limit", "10"),
+					resource.TestCheckResourceAttr(
+						"litellm_default_team_settings.test", "previous_settings.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceDefaultTeamSettingsConfig_basic() string {
+	return `
+resource "litellm_default_team_settings" "test" {
+  models            = ["gpt-4"]
+  tpm_limit         = 100000
+  restore_on_delete = false
+}
+`
+}
+
+func testAccResourceDefaultTeamSettingsConfig_restoreOnDelete() string {
+	return `
+resource "litellm_default_team_settings" "test" {
+  max_budget      = 25
+  budget_duration = "30d"
+  rpm_limit       = 10
+}
+`
+}
@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+// Write-only secrets are not returned by the API, so drift is tracked with a
+// fingerprint of the last value sent: "<salt>:<sha256(salt + secret)>" in
+// hex. The salt keeps equal secrets from having equal fingerprints across
+// resources.
+
+// newSecretFingerprint returns a fingerprint of secret with a fresh salt, or
+// "" for an empty secret.
+func newSecretFingerprint(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(salt) + ":" + hashSecret(salt, secret), nil
+}
+
+// secretFingerprintMatches reports whether fingerprint was made from secret.
+func secretFingerprintMatches(fingerprint, secret string) bool {
+	if fingerprint == "" || secret == "" {
+		return fingerprint == secret
+	}
+
+	parts := strings.SplitN(fingerprint, ":", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(hashSecret(salt, secret)), []byte(parts[1])) == 1
+}
+
+// updateSecretFingerprint returns fingerprint unchanged if it still matches
+// secret, and a new fingerprint otherwise.
+func updateSecretFingerprint(fingerprint, secret string) (string, error) {
+	if secretFingerprintMatches(fingerprint, secret) {
+		return fingerprint, nil
+	}
+	return newSecretFingerprint(secret)
+}
+
+func hashSecret(salt []byte, secret string) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(secret))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// secretMapValue serializes a map of secrets so it can be fingerprinted as
+// one value. encoding/json sorts the keys and quotes keys and values, so
+// distinct maps never serialize the same.
+func secretMapValue(secrets map[string]string) string {
+	if len(secrets) == 0 {
+		return ""
+	}
+	b, _ := json.Marshal(secrets)
+	return string(b)
+}
+
+// maskedValueMatches reports whether a value returned by the proxy could
+// have been made from secret. The proxy masks secrets by replacing their
+// middle with '*', so only the visible prefix and suffix are compared. Most
+// secrets are not returned at all; an empty value cannot be compared and
+// matches any secret.
+func maskedValueMatches(returned, secret string) bool {
+	if returned == "" {
+		return true
+	}
+
+	first := strings.Index(returned, "*")
+	if first < 0 {
+		return returned == secret
+	}
+	last := strings.LastIndex(returned, "*")
+
+	prefix, suffix := returned[:first], returned[last+1:]
+	return len(secret) >= len(prefix)+len(suffix) &&
+		strings.HasPrefix(secret, prefix) &&
+		strings.HasSuffix(secret, suffix)
+}
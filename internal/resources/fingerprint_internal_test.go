@@ -0,0 +1,93 @@
+package resources
+
+import "testing"
+
+func TestSecretFingerprint(t *testing.T) {
+	first, err := newSecretFingerprint("sk-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := newSecretFingerprint("sk-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Errorf("fingerprints of the same secret should be salted differently, both are %q", first)
+	}
+
+	cases := []struct {
+		name        string
+		fingerprint string
+		secret      string
+		want        bool
+	}{
+		{"same secret", first, "sk-secret", true},
+		{"same secret, other salt", second, "sk-secret", true},
+		{"other secret", first, "sk-other", false},
+		{"secret removed", first, "", false},
+		{"secret added", "", "sk-secret", false},
+		{"no secret", "", "", true},
+		{"malformed", "not-a-fingerprint", "sk-secret", false},
+		{"bad salt", "zz:" + first[33:], "sk-secret", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := secretFingerprintMatches(tc.fingerprint, tc.secret); got != tc.want {
+				t.Errorf("secretFingerprintMatches(%q, %q) = %v, want %v", tc.fingerprint, tc.secret, got, tc.want)
+			}
+		})
+	}
+
+	if fp, err := newSecretFingerprint(""); err != nil || fp != "" {
+		t.Errorf("newSecretFingerprint(\"\") = %q, %v, want no fingerprint", fp, err)
+	}
+	if fp, err := updateSecretFingerprint(first, "sk-secret"); err != nil || fp != first {
+		t.Errorf("updateSecretFingerprint kept %q, want %q", fp, first)
+	}
+	if fp, err := updateSecretFingerprint(first, "sk-other"); err != nil || !secretFingerprintMatches(fp, "sk-other") {
+		t.Errorf("updateSecretFingerprint(%q, sk-other) = %q, want a fingerprint of sk-other", first, fp)
+	}
+}
+
+func TestSecretMapValue(t *testing.T) {
+	a := secretMapValue(map[string]string{"api_key": "sk-1", "api_base": "https://example.com"})
+	b := secretMapValue(map[string]string{"api_base": "https://example.com", "api_key": "sk-1"})
+	if a != b {
+		t.Errorf("secretMapValue depends on map order: %q != %q", a, b)
+	}
+	if secretMapValue(map[string]string{"a": "b=c"}) == secretMapValue(map[string]string{"a=b": "c"}) {
+		t.Error("secretMapValue should keep keys and values apart")
+	}
+	if got := secretMapValue(nil); got != "" {
+		t.Errorf("secretMapValue(nil) = %q, want empty", got)
+	}
+}
+
+func TestMaskedValueMatches(t *testing.T) {
+	cases := []struct {
+		name     string
+		returned string
+		secret   string
+		want     bool
+	}{
+		{"masked prefix and suffix", "sk-p********abcd", "sk-proj-1234abcd", true},
+		{"masked prefix only", "sk-p****", "sk-proj-1234", true},
+		{"masked suffix only", "****abcd", "sk-proj-1234abcd", true},
+		{"other prefix", "sk-q********abcd", "sk-proj-1234abcd", false},
+		{"other suffix", "sk-p********abce", "sk-proj-1234abcd", false},
+		{"secret shorter than the visible parts", "sk-p****abcd", "sk-pabc", false},
+		{"unmasked equal", "https://example.com", "https://example.com", true},
+		{"unmasked different", "https://example.com", "https://example.org", false},
+		{"env reference", "os.environ/OPENAI_API_KEY", "os.environ/OPENAI_API_KEY", true},
+		{"not returned", "", "sk-proj-1234abcd", true},
+		{"set on the proxy only", "sk-p****abcd", "", false},
+		{"neither set", "", "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := maskedValueMatches(tc.returned, tc.secret); got != tc.want {
+				t.Errorf("maskedValueMatches(%q, %q) = %v, want %v", tc.returned, tc.secret, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+var internalUserSettings = proxySettings{
+	name:        "internal_user_settings",
+	description: "internal user settings",
+	attributes: func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"user_role": {
+				Type:         schema.TypeString,
+				Description:  "Default role for new users",
+				ValidateFunc: validation.OneOf("proxy_admin", "proxy_admin_viewer", "internal_user", "internal_user_viewer"),
+			},
+			"max_budget": {
+				Type:         schema.TypeFloat,
+				Description:  "Default maximum budget in USD for new users",
+				ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+			},
+			"budget_duration": {
+				Type:        schema.TypeString,
+				Description: "Default budget reset period for new users (e.g. '30d')",
+			},
+			"models": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Default list of models new users can access",
+			},
+		}
+	},
+}
+
+// ResourceInternalUserSettings manages the proxy-wide defaults for new users.
+func ResourceInternalUserSettings() *schema.Resource {
+	return internalUserSettings.resource()
+}
@@ -0,0 +1,105 @@
+package resources_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceInternalUserSettings_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		CheckDestroy: testAccCheckSettingsRestored(
+			"litellm_internal_user_settings.test", "internal_user_settings"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceInternalUserSettingsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_internal_user_settings.test", "user_role", "internal_user_viewer"),
+					resource.TestCheckResourceAttr(
+						"litellm_internal_user_settings.test", "max_budget", "10"),
+					resource.TestCheckResourceAttr(
+						"litellm_internal_user_settings.test", "previous_settings.#", "1"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:            "litellm_internal_user_settings.test",
+				ImportState:             true,
+				ImportStateId:           "internal_user_settings",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"restore_on_delete", "previous_settings"},
+			},
+		},
+	})
+}
+
+func testAccResourceInternalUserSettingsConfig_basic() string {
+	return `
+resource "litellm_internal_user_settings" "test" {
+  user_role       = "internal_user_viewer"
+  max_budget      = 10
+  budget_duration = "30d"
+}
+`
+}
+
+// testAccCheckSettingsRestored checks that the proxy's settings match the
+// previous_settings recorded in the resource's state before it was destroyed.
+func testAccCheckSettingsRestored(resourceName, settingsName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+		previous := rs.Primary.Attributes
+
+		c := provider.TestAccProvider.Meta().(*client.Client)
+		values, err := c.GetSettings(settingsName)
+		if err != nil {
+			return err
+		}
+
+		for k, v := range values {
+			if l, ok := v.([]interface{}); ok {
+				want := previous["previous_settings.0."+k+".#"]
+				if want == "" {
+					want = "0"
+				}
+				if want != strconv.Itoa(len(l)) {
+					return fmt.Errorf("%s has %d entries after destroy, want %s", k, len(l), want)
+				}
+				for i, e := range l {
+					if want := previous[fmt.Sprintf("previous_settings.0.%s.%d", k, i)]; fmt.Sprint(e) != want {
+						return fmt.Errorf("%s[%d] is %v after destroy, want %s", k, i, e, want)
+					}
+				}
+				continue
+			}
+
+			want, recorded := previous["previous_settings.0."+k]
+			if !recorded {
+				continue
+			}
+			if v == nil {
+				v = ""
+			}
+			got := fmt.Sprint(v)
+			if want == "0" && got == "" {
+				want = ""
+			}
+			if got != want {
+				return fmt.Errorf("%s is %s after destroy, want %s", k, got, want)
+			}
+		}
+
+		return nil
+	}
+}
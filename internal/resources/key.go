@@ -3,10 +3,14 @@ package resources
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
 )
 
 func ResourceKey() *schema.Resource {
@@ -15,104 +19,304 @@ func ResourceKey() *schema.Resource {
 		ReadContext:   resourceKeyRead,
 		UpdateContext: resourceKeyUpdate,
 		DeleteContext: resourceKeyDelete,
+		CustomizeDiff: resourceKeyCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeyImport,
+		},
 
-		Schema: map[string]*schema.Schema{
-			"key_alias": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: "Alias for the key",
-				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
-					v, ok := i.(string)
-					if !ok {
-						return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
-					}
-					if v == "" {
-						return nil, []error{fmt.Errorf("%s cannot be empty", k)}
-					}
-					if len(v) < 3 {
-						return nil, []error{fmt.Errorf("%s must be at least 3 characters", k)}
-					}
-					return nil, nil
-				},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceKeyV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceKeyStateUpgradeV0,
 			},
-			"team_id": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Team ID associated with the key",
-				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
-					v, ok := i.(string)
-					if !ok {
-						return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
-					}
-					if v == "" {
-						return nil, []error{fmt.Errorf("%s cannot be empty", k)}
-					}
-					return nil, nil
-				},
+		},
+
+		Schema: resourceKeySchema(),
+	}
+}
+
+func resourceKeySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"key_alias": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Alias for the key. Aliases need not be unique, and can be changed in place",
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				v, ok := i.(string)
+				if !ok {
+					return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+				}
+				if v == "" {
+					return nil, []error{fmt.Errorf("%s cannot be empty", k)}
+				}
+				if len(v) < 3 {
+					return nil, []error{fmt.Errorf("%s must be at least 3 characters", k)}
+				}
+				return nil, nil
 			},
-			"models": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-				Description: "List of models this key has access to",
+		},
+		"team_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Team ID associated with the key",
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				v, ok := i.(string)
+				if !ok {
+					return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+				}
+				if v == "" {
+					return nil, []error{fmt.Errorf("%s cannot be empty", k)}
+				}
+				return nil, nil
 			},
-			"max_budget": {
-				Type:        schema.TypeFloat,
-				Optional:    true,
-				Description: "Maximum budget allowed for this key",
-				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
-					v, ok := i.(float64)
-					if !ok {
-						return nil, []error{fmt.Errorf("expected type of %s to be float64", k)}
-					}
-					if v < 0 {
-						return nil, []error{fmt.Errorf("%s cannot be negative", k)}
-					}
-					return nil, nil
-				},
+		},
+		"models": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
-			"expires_at": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Expiration timestamp for the key",
+			Description: "List of models this key has access to",
+		},
+		"max_budget": {
+			Type:        schema.TypeFloat,
+			Optional:    true,
+			Description: "Maximum budget allowed for this key",
+			ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+				v, ok := i.(float64)
+				if !ok {
+					return nil, []error{fmt.Errorf("expected type of %s to be float64", k)}
+				}
+				if v < 0 {
+					return nil, []error{fmt.Errorf("%s cannot be negative", k)}
+				}
+				return nil, nil
 			},
-			"key": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Sensitive:   true,
-				Description: "The generated API key",
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Expiration timestamp for the key",
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: "List of tags attached to requests made with this key, used for routing and spend tracking",
+		},
+		"user_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "User the key belongs to",
+		},
+		"duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "How long the key is valid for from when it is created or this value is changed (e.g. '30d'). The proxy does not return it, so it is not read back",
+			ValidateFunc: validation.Duration,
+		},
+		"soft_budget": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Spend in USD after which budget alerts are sent for this key",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"budget_duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "How often the key's spend is reset (e.g. '30d')",
+			ValidateFunc: validation.Duration,
+		},
+		"tpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Tokens per minute limit for this key",
+		},
+		"rpm_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Requests per minute limit for this key",
+		},
+		"max_parallel_requests": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Maximum number of concurrent requests allowed for this key",
+		},
+		"metadata":      metadataSchema("Arbitrary metadata stored with the key"),
+		"metadata_json": metadataJSONSchema("Arbitrary metadata stored with the key"),
+		"aliases": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Model aliases for this key, mapping the requested model name to the model that serves it",
+		},
+		"config": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Key-specific proxy config",
+		},
+		"permissions": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeBool},
+			Description: "Key-specific permissions, e.g. `get_spend_routes`",
+		},
+		"model_max_budget": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Budgets and rate limits applied to individual models",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"model": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "The model the budget applies to",
+						ValidateFunc: validation.StringNotEmpty,
+					},
+					"max_budget": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						Description:  "Maximum spend in USD on this model",
+						ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+					},
+					"budget_duration": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Description:  "How often spend on this model is reset (e.g. '30d')",
+						ValidateFunc: validation.Duration,
+					},
+					"tpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Tokens per minute limit on this model",
+					},
+					"rpm_limit": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Requests per minute limit on this model",
+					},
+				},
 			},
 		},
+		"model_rpm_limit": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Requests per minute limits applied to individual models",
+			Elem:        ModelLimitResource(false),
+		},
+		"model_tpm_limit": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Tokens per minute limits applied to individual models",
+			Elem:        ModelLimitResource(false),
+		},
+		"allowed_cache_controls": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.OneOf("no-cache", "no-store", "ttl", "s-maxage"),
+			},
+			Description: "Cache-control values requests made with this key may set",
+		},
+		"guardrails": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Guardrails applied to requests made with this key",
+		},
+		"enforced_params": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Request parameters that must be present on every request made with this key (e.g. 'user', 'metadata.generation_name')",
+		},
+		"allowed_routes": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Proxy routes this key may call (e.g. '/chat/completions'). All routes are allowed if empty",
+		},
+		"blocked": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the key is blocked. Blocking keeps the key and its spend history but rejects all requests made with it",
+		},
+		"rotation_triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Arbitrary values that regenerate the key in place when changed, like the `keepers` of `random_id`",
+		},
+		"rotation_period": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "Regenerate the key in place once this long has passed since `last_rotated_at` (e.g. '720h' or '30d'). Checked at plan time",
+			ValidateFunc: validation.Duration,
+		},
+		"last_rotated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the key was created or last regenerated (RFC 3339)",
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The hashed key the proxy uses to identify the key. Also used as the resource ID",
+		},
+		"spend": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Spend in USD on this key since its budget was last reset",
+		},
+		"budget_reset_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the key's spend is next reset",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the key was created",
+		},
+		"last_active": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the key was last used",
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The generated API key",
+		},
 	}
 }
 
 func resourceKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
-	key := &client.Key{
-		KeyAlias:  d.Get("key_alias").(string),
-		TeamID:    d.Get("team_id").(string),
-		MaxBudget: d.Get("max_budget").(float64),
-		ExpiresAt: d.Get("expires_at").(string),
-	}
-
-	if v, ok := d.GetOk("models"); ok {
-		models := make([]string, 0)
-		for _, model := range v.([]interface{}) {
-			models = append(models, model.(string))
-		}
-		key.Models = models
-	}
+	key := expandKey(d)
+	key.KeyAlias = d.Get("key_alias").(string)
+	key.Blocked = d.Get("blocked").(bool)
 
 	if err := c.CreateKey(key); err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(key.KeyAlias)
+	if key.Token == "" {
+		return diag.Errorf("key %s was created but the proxy did not return its hashed token", key.KeyAlias)
+	}
+
+	d.SetId(key.Token)
 	d.Set("key", key.Key)
+	d.Set("last_rotated_at", time.Now().UTC().Format(time.RFC3339))
 
 	return resourceKeyRead(ctx, d, m)
 }
@@ -135,7 +339,38 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	d.Set("models", key.Models)
 	d.Set("max_budget", key.MaxBudget)
 	d.Set("expires_at", key.ExpiresAt)
-	// Note: The actual key value is only available during creation
+	d.Set("tags", key.Tags)
+	d.Set("blocked", key.Blocked)
+	d.Set("user_id", key.UserID)
+	d.Set("soft_budget", key.SoftBudget)
+	d.Set("budget_duration", key.BudgetDuration)
+	d.Set("tpm_limit", key.TPMLimit)
+	d.Set("rpm_limit", key.RPMLimit)
+	d.Set("max_parallel_requests", key.MaxParallelRequests)
+	if err := flattenMetadata(d, key.Metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("aliases", key.Aliases)
+	d.Set("config", flattenKeyConfig(key.Config))
+	d.Set("permissions", key.Permissions)
+	d.Set("model_max_budget", flattenModelMaxBudget(key.ModelMaxBudget))
+	d.Set("model_rpm_limit", FlattenModelLimits(key.ModelRPMLimit))
+	d.Set("model_tpm_limit", FlattenModelLimits(key.ModelTPMLimit))
+	d.Set("allowed_cache_controls", key.AllowedCacheControls)
+	d.Set("guardrails", key.Guardrails)
+	d.Set("enforced_params", key.EnforcedParams)
+	d.Set("allowed_routes", key.AllowedRoutes)
+	d.Set("token", key.Token)
+	d.Set("spend", key.Spend)
+	d.Set("budget_reset_at", key.BudgetResetAt)
+	d.Set("created_at", key.CreatedAt)
+	d.Set("last_active", key.LastActive)
+	// Imported keys and keys from before rotation was tracked have no
+	// timestamp; start the rotation period when the key was created.
+	if d.Get("last_rotated_at").(string) == "" {
+		d.Set("last_rotated_at", keyRotationBaseline(key))
+	}
+	// Note: The actual key value and duration are only available during creation
 
 	return nil
 }
@@ -143,28 +378,161 @@ func resourceKeyRead(ctx context.Context, d *schema.ResourceData, m interface{})
 func resourceKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
-	key := &client.Key{
-		KeyAlias:  d.Id(),
-		TeamID:    d.Get("team_id").(string),
-		MaxBudget: d.Get("max_budget").(float64),
-		ExpiresAt: d.Get("expires_at").(string),
+	if d.HasChangesExcept("blocked", "rotation_triggers", "rotation_period", "last_rotated_at", "key", "token") {
+		if diags := resourceKeyUpdateSettings(ctx, d, c); diags.HasError() {
+			return diags
+		}
 	}
 
-	if v, ok := d.GetOk("models"); ok {
-		models := make([]string, 0)
-		for _, model := range v.([]interface{}) {
-			models = append(models, model.(string))
+	if d.HasChange("blocked") {
+		if diags := resourceKeyUpdateBlocked(ctx, d, c); diags.HasError() {
+			return diags
 		}
-		key.Models = models
 	}
 
-	if err := c.UpdateKey(key); err != nil {
-		return diag.FromErr(err)
+	// resourceKeyCustomizeDiff marks last_rotated_at as changing whenever a
+	// trigger changed or the rotation period elapsed.
+	if d.HasChange("last_rotated_at") {
+		if diags := resourceKeyRotate(ctx, d, c); diags.HasError() {
+			return diags
+		}
 	}
 
 	return resourceKeyRead(ctx, d, m)
 }
 
+func resourceKeyUpdateSettings(ctx context.Context, d *schema.ResourceData, c *client.Client) diag.Diagnostics {
+	key := expandKey(d)
+	key.KeyAlias = d.Get("key_alias").(string)
+
+	// duration restarts the key's validity, so only send it when it changed.
+	if !d.HasChange("duration") {
+		key.Duration = ""
+	}
+
+	if err := c.UpdateKey(d.Id(), key, clearedKeyFields(d)...); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// clearedKeyFields returns the request fields of the settings removed from
+// the configuration. expandKey leaves them empty, which /key/update would
+// otherwise take as "unchanged".
+func clearedKeyFields(d *schema.ResourceData) []string {
+	var cleared []string
+	for _, attr := range []string{
+		"user_id", "models", "max_budget", "soft_budget", "budget_duration",
+		"expires_at", "tpm_limit", "rpm_limit", "max_parallel_requests",
+		"aliases", "config", "permissions", "model_max_budget", "model_rpm_limit",
+		"model_tpm_limit", "allowed_cache_controls", "guardrails",
+		"enforced_params", "allowed_routes", "tags",
+	} {
+		if _, ok := d.GetOk(attr); d.HasChange(attr) && !ok {
+			cleared = append(cleared, attr)
+		}
+	}
+
+	if d.HasChanges("metadata", "metadata_json") && len(expandMetadata(d)) == 0 {
+		cleared = append(cleared, "metadata")
+	}
+
+	return cleared
+}
+
+// resourceKeyUpdateBlocked toggles blocking through /key/block and
+// /key/unblock and checks that the proxy applied it.
+func resourceKeyUpdateBlocked(ctx context.Context, d *schema.ResourceData, c *client.Client) diag.Diagnostics {
+	blocked := d.Get("blocked").(bool)
+
+	var err error
+	if blocked {
+		err = c.BlockKey(d.Id())
+	} else {
+		err = c.UnblockKey(d.Id())
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := c.GetKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if key == nil {
+		return diag.Errorf("key %s not found after changing blocked", d.Id())
+	}
+	if key.Blocked != blocked {
+		return diag.Errorf("key %s still has blocked = %t after requesting %t", d.Id(), key.Blocked, blocked)
+	}
+
+	return nil
+}
+
+// resourceKeyRotate regenerates the key through /key/{key}/regenerate, which
+// keeps its settings and spend. The new secret has a new hashed token, so the
+// resource ID moves with it.
+func resourceKeyRotate(ctx context.Context, d *schema.ResourceData, c *client.Client) diag.Diagnostics {
+	regenerated, err := c.RegenerateKey(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if regenerated.Token != "" {
+		d.SetId(regenerated.Token)
+	}
+	d.Set("key", regenerated.Key)
+	d.Set("last_rotated_at", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+// keyRotationBaseline returns the key's creation time as an RFC 3339
+// last_rotated_at, or the current time if the proxy did not report a
+// parseable one.
+func keyRotationBaseline(key *client.Key) string {
+	if created, err := time.Parse(time.RFC3339, key.CreatedAt); err == nil {
+		return created.UTC().Format(time.RFC3339)
+	}
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// resourceKeyCustomizeDiff plans an in-place rotation when rotation_triggers
+// changed or rotation_period has elapsed since last_rotated_at.
+func resourceKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	rotate := d.HasChange("rotation_triggers")
+
+	if period := d.Get("rotation_period").(string); period != "" && !rotate {
+		duration, err := validation.ParseDuration(period)
+		if err != nil {
+			return err
+		}
+
+		// A missing timestamp is filled in by the next refresh rather than
+		// treated as overdue.
+		if lastRotated, err := time.Parse(time.RFC3339, d.Get("last_rotated_at").(string)); err == nil {
+			rotate = !time.Now().Before(lastRotated.Add(duration))
+		}
+	}
+
+	if !rotate {
+		return nil
+	}
+
+	if err := d.SetNewComputed("key"); err != nil {
+		return err
+	}
+	if err := d.SetNewComputed("token"); err != nil {
+		return err
+	}
+	return d.SetNewComputed("last_rotated_at")
+}
+
 func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
@@ -176,3 +544,237 @@ func resourceKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{
 
 	return nil
 }
+
+// resourceKeyV0 is the schema from before the ID switched from key_alias to
+// the hashed token. It only needs the attribute types to decode old state, so
+// validation is left out.
+func resourceKeyV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"key_alias": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"team_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"models": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"max_budget": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// resourceKeyStateUpgradeV0 replaces an alias ID with the hashed token of the
+// key with that alias. Aliases are not unique, so the team is used to pick
+// between matches.
+func resourceKeyStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	c := m.(*client.Client)
+
+	alias, _ := rawState["id"].(string)
+	if alias == "" {
+		return rawState, nil
+	}
+	teamID, _ := rawState["team_id"].(string)
+
+	keys, err := c.ListKeys(alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up key %s while upgrading state: %w", alias, err)
+	}
+
+	var matches []client.Key
+	for _, key := range keys {
+		if teamID == "" || key.TeamID == teamID {
+			matches = append(matches, key)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		// The key is gone; leave the ID so the next refresh removes it.
+		return rawState, nil
+	case 1:
+		rawState["id"] = matches[0].Token
+		rawState["token"] = matches[0].Token
+		return rawState, nil
+	}
+
+	return nil, fmt.Errorf("found %d keys with alias %s in team %s; remove the key from state and import it by its hashed token", len(matches), alias, teamID)
+}
+
+// keyTokenPattern matches the hashed token the proxy identifies keys by.
+var keyTokenPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// resourceKeyImport accepts a key's hashed token, the key itself, or a key
+// alias that only one key uses.
+func resourceKeyImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.Client)
+
+	id := d.Id()
+	if keyTokenPattern.MatchString(id) || strings.HasPrefix(id, "sk-") {
+		key, err := c.GetKey(id)
+		if err != nil {
+			return nil, err
+		}
+		if key == nil {
+			return nil, fmt.Errorf("key %s not found", id)
+		}
+		if strings.HasPrefix(id, "sk-") {
+			d.Set("key", id)
+		}
+		d.SetId(key.Token)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	keys, err := c.ListKeys(id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(keys) {
+	case 0:
+		return nil, fmt.Errorf("no key with alias %s found", id)
+	case 1:
+		d.SetId(keys[0].Token)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("found %d keys with alias %s; import one by its hashed token", len(keys), id)
+}
+
+// expandKey builds the settings shared by key creation and /key/update.
+func expandKey(d *schema.ResourceData) *client.Key {
+	key := &client.Key{
+		TeamID:               d.Get("team_id").(string),
+		UserID:               d.Get("user_id").(string),
+		Models:               expandStringList(d.Get("models").([]interface{})),
+		MaxBudget:            d.Get("max_budget").(float64),
+		SoftBudget:           d.Get("soft_budget").(float64),
+		BudgetDuration:       d.Get("budget_duration").(string),
+		Duration:             d.Get("duration").(string),
+		ExpiresAt:            d.Get("expires_at").(string),
+		TPMLimit:             d.Get("tpm_limit").(int),
+		RPMLimit:             d.Get("rpm_limit").(int),
+		MaxParallelRequests:  d.Get("max_parallel_requests").(int),
+		Metadata:             expandMetadata(d),
+		Aliases:              expandStringMap(d.Get("aliases").(map[string]interface{})),
+		Config:               d.Get("config").(map[string]interface{}),
+		Permissions:          make(map[string]bool),
+		ModelMaxBudget:       make(map[string]client.BudgetConfig),
+		ModelRPMLimit:        expandModelLimits(d.Get("model_rpm_limit").(*schema.Set)),
+		ModelTPMLimit:        expandModelLimits(d.Get("model_tpm_limit").(*schema.Set)),
+		AllowedCacheControls: expandStringList(d.Get("allowed_cache_controls").([]interface{})),
+		Guardrails:           expandStringList(d.Get("guardrails").([]interface{})),
+		EnforcedParams:       expandStringList(d.Get("enforced_params").([]interface{})),
+		AllowedRoutes:        expandStringList(d.Get("allowed_routes").([]interface{})),
+		Tags:                 expandStringList(d.Get("tags").([]interface{})),
+	}
+
+	for k, v := range d.Get("permissions").(map[string]interface{}) {
+		key.Permissions[k] = v.(bool)
+	}
+
+	for _, raw := range d.Get("model_max_budget").(*schema.Set).List() {
+		b := raw.(map[string]interface{})
+		key.ModelMaxBudget[b["model"].(string)] = client.BudgetConfig{
+			MaxBudget:      b["max_budget"].(float64),
+			BudgetDuration: b["budget_duration"].(string),
+			TPMLimit:       b["tpm_limit"].(int),
+			RPMLimit:       b["rpm_limit"].(int),
+		}
+	}
+
+	return key
+}
+
+// ModelLimitResource is the block schema for per-model rate limits. The
+// key data source uses it with computedOnly set.
+func ModelLimitResource(computedOnly bool) *schema.Resource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The model the limit applies to",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The limit for this model",
+			},
+		},
+	}
+
+	if computedOnly {
+		for _, v := range r.Schema {
+			v.Required = false
+			v.Computed = true
+			v.ValidateFunc = nil
+		}
+	}
+
+	return r
+}
+
+func expandModelLimits(s *schema.Set) map[string]int {
+	result := make(map[string]int, s.Len())
+	for _, raw := range s.List() {
+		l := raw.(map[string]interface{})
+		result[l["model"].(string)] = l["limit"].(int)
+	}
+	return result
+}
+
+// FlattenModelLimits converts per-model limits to ModelLimitResource blocks.
+func FlattenModelLimits(limits map[string]int) []interface{} {
+	result := make([]interface{}, 0, len(limits))
+	for model, limit := range limits {
+		result = append(result, map[string]interface{}{
+			"model": model,
+			"limit": limit,
+		})
+	}
+	return result
+}
+
+func flattenModelMaxBudget(budgets map[string]client.BudgetConfig) []interface{} {
+	result := make([]interface{}, 0, len(budgets))
+	for model, b := range budgets {
+		result = append(result, map[string]interface{}{
+			"model":           model,
+			"max_budget":      b.MaxBudget,
+			"budget_duration": b.BudgetDuration,
+			"tpm_limit":       b.TPMLimit,
+			"rpm_limit":       b.RPMLimit,
+		})
+	}
+	return result
+}
+
+// flattenKeyConfig renders config values as strings, since the proxy may
+// return numbers and booleans for values that were sent as strings.
+func flattenKeyConfig(config map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(config))
+	for k, v := range config {
+		result[k] = fmt.Sprint(v)
+	}
+	return result
+}
@@ -0,0 +1,340 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+const testKeyToken = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+// keyUpdateData returns the ResourceData for an update from a key with the
+// given state attributes to config.
+func keyUpdateData(t *testing.T, attributes map[string]string, config map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	r := ResourceKey()
+	state := &terraform.InstanceState{ID: testKeyToken, Attributes: attributes}
+	diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+	if err != nil {
+		t.Fatalf("diff: %v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("data: %v", err)
+	}
+	return d
+}
+
+func TestResourceKeyUpdateSettings_clearsRemovedFields(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/key/update" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	limit := strconv.Itoa(schema.HashResource(ModelLimitResource(false))(map[string]interface{}{
+		"model": "gpt-4o",
+		"limit": 10,
+	}))
+	d := keyUpdateData(t, map[string]string{
+		"id":                                  testKeyToken,
+		"key_alias":                           "search-service",
+		"team_id":                             "search",
+		"models.#":                            "1",
+		"models.0":                            "gpt-4o",
+		"max_budget":                          "10",
+		"guardrails.#":                        "1",
+		"guardrails.0":                        "pii",
+		"metadata.%":                          "1",
+		"metadata.owner":                      "search",
+		"aliases.%":                           "1",
+		"aliases.gpt":                         "gpt-4o",
+		"model_rpm_limit.#":                   "1",
+		"model_rpm_limit." + limit + ".model": "gpt-4o",
+		"model_rpm_limit." + limit + ".limit": "10",
+		"tpm_limit":                           "1000",
+		"allowed_routes.#":                    "0",
+		"rotation_triggers.%":                 "0",
+		"model_max_budget.#":                  "0",
+		"model_tpm_limit.#":                   "0",
+		"config.%":                            "0",
+		"permissions.%":                       "0",
+		"allowed_cache_controls.#":            "0",
+		"enforced_params.#":                   "0",
+		"tags.#":                              "0",
+	}, map[string]interface{}{
+		"key_alias": "search-service",
+		"team_id":   "search",
+		"models":    []interface{}{"gpt-4o"},
+		"tpm_limit": 2000,
+	})
+
+	if diags := resourceKeyUpdateSettings(context.Background(), d, client.NewClient("sk-admin", server.URL)); diags.HasError() {
+		t.Fatalf("update: %v", diags)
+	}
+
+	want := map[string]interface{}{
+		"key":             testKeyToken,
+		"key_alias":       "search-service",
+		"team_id":         "search",
+		"models":          []interface{}{"gpt-4o"},
+		"tpm_limit":       2000.0,
+		"max_budget":      nil,
+		"guardrails":      []interface{}{},
+		"metadata":        map[string]interface{}{},
+		"aliases":         map[string]interface{}{},
+		"model_rpm_limit": map[string]interface{}{},
+	}
+	if !reflect.DeepEqual(body, want) {
+		t.Errorf("/key/update body = %v, want %v", body, want)
+	}
+}
+
+func TestResourceKeyRead_proxyStoredFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/key/info" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.Write([]byte(`{
+			"key": "` + testKeyToken + `",
+			"info": {
+				"token": "` + testKeyToken + `",
+				"key_alias": "search-service",
+				"team_id": "search",
+				"expires": "2030-01-01T00:00:00Z",
+				"created_at": "2024-01-01T00:00:00Z",
+				"litellm_budget_table": {"soft_budget": 5},
+				"metadata": {
+					"owner": "search",
+					"tags": ["prod"],
+					"guardrails": ["pii"],
+					"enforced_params": ["user"],
+					"model_rpm_limit": {"gpt-4o": 10},
+					"model_tpm_limit": {"gpt-4o": 1000}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceKey().Schema, map[string]interface{}{})
+	d.SetId(testKeyToken)
+
+	if diags := resourceKeyRead(context.Background(), d, client.NewClient("sk-admin", server.URL)); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	for attr, want := range map[string]interface{}{
+		"expires_at":      "2030-01-01T00:00:00Z",
+		"soft_budget":     5.0,
+		"tags":            []interface{}{"prod"},
+		"guardrails":      []interface{}{"pii"},
+		"enforced_params": []interface{}{"user"},
+		"metadata":        map[string]interface{}{"owner": "search"},
+		"last_rotated_at": "2024-01-01T00:00:00Z",
+	} {
+		if got := d.Get(attr); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s = %v, want %v", attr, got, want)
+		}
+	}
+
+	for attr, want := range map[string]int{"model_rpm_limit": 10, "model_tpm_limit": 1000} {
+		got := d.Get(attr).(*schema.Set).List()
+		if len(got) != 1 || got[0].(map[string]interface{})["model"] != "gpt-4o" || got[0].(map[string]interface{})["limit"] != want {
+			t.Errorf("%s = %v, want gpt-4o: %d", attr, got, want)
+		}
+	}
+}
+
+func TestResourceKeyRead_largeMetadataNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"info": {"token": "` + testKeyToken + `", "metadata": {"account": 9007199254740993}}}`))
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, ResourceKey().Schema, map[string]interface{}{})
+	d.SetId(testKeyToken)
+
+	if diags := resourceKeyRead(context.Background(), d, client.NewClient("sk-admin", server.URL)); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	if got, want := d.Get("metadata_json"), `{"account":9007199254740993}`; got != want {
+		t.Errorf("metadata_json = %v, want %s", got, want)
+	}
+}
+
+func TestResourceKeyCustomizeDiff_rotationPeriod(t *testing.T) {
+	cases := []struct {
+		name         string
+		lastRotated  string
+		wantRotation bool
+	}{
+		{"not yet due", time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339), false},
+		{"due", time.Now().Add(-31 * 24 * time.Hour).UTC().Format(time.RFC3339), true},
+		{"no timestamp", "", false},
+		{"unparseable timestamp", "2024-01-01 00:00:00", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &terraform.InstanceState{ID: testKeyToken, Attributes: map[string]string{
+				"id":              testKeyToken,
+				"token":           testKeyToken,
+				"key_alias":       "search-service",
+				"rotation_period": "30d",
+				"last_rotated_at": tc.lastRotated,
+			}}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"key_alias":       "search-service",
+				"rotation_period": "30d",
+			})
+
+			diff, err := ResourceKey().SimpleDiff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatalf("diff: %v", err)
+			}
+
+			for _, attr := range []string{"key", "token", "last_rotated_at"} {
+				rotated := diff != nil && diff.Attributes[attr] != nil && diff.Attributes[attr].NewComputed
+				if rotated != tc.wantRotation {
+					t.Errorf("%s recomputed = %t, want %t", attr, rotated, tc.wantRotation)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceKeyV0_decodesBaselineState(t *testing.T) {
+	// State as written by releases that identified keys by alias
+	state := []byte(`{
+		"id": "search-service",
+		"key_alias": "search-service",
+		"team_id": "search",
+		"models": ["gpt-4o"],
+		"max_budget": 10,
+		"expires_at": "",
+		"key": "sk-1234"
+	}`)
+
+	if _, err := ctyjson.Unmarshal(state, resourceKeyV0().CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatalf("baseline state does not match the v0 schema: %v", err)
+	}
+}
+
+func TestResourceKeyStateUpgradeV0(t *testing.T) {
+	token := func(c byte) string { return strings.Repeat(string(c), 64) }
+
+	cases := []struct {
+		name    string
+		teamID  string
+		keys    []map[string]interface{}
+		status  int
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name:   "no match",
+			teamID: "search",
+			keys:   []map[string]interface{}{},
+			wantID: "search-service",
+		},
+		{
+			name:   "one match",
+			teamID: "search",
+			keys: []map[string]interface{}{
+				{"key_alias": "search-service", "team_id": "search", "token": token('a')},
+			},
+			wantID: token('a'),
+		},
+		{
+			name:   "several matches in different teams",
+			teamID: "search",
+			keys: []map[string]interface{}{
+				{"key_alias": "search-service", "team_id": "ads", "token": token('a')},
+				{"key_alias": "search-service", "team_id": "search", "token": token('b')},
+			},
+			wantID: token('b'),
+		},
+		{
+			name:   "several matches in the same team",
+			teamID: "search",
+			keys: []map[string]interface{}{
+				{"key_alias": "search-service", "team_id": "search", "token": token('a')},
+				{"key_alias": "search-service", "team_id": "search", "token": token('b')},
+			},
+			wantErr: true,
+		},
+		{
+			name:   "other aliases ignored",
+			teamID: "search",
+			keys: []map[string]interface{}{
+				{"key_alias": "search-service-2", "team_id": "search", "token": token('a')},
+				{"key_alias": "search-service", "team_id": "search", "token": token('b')},
+			},
+			wantID: token('b'),
+		},
+		{
+			name:    "proxy error",
+			teamID:  "search",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/key/list" || r.URL.Query().Get("key_alias") != "search-service" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				if tc.status != 0 {
+					http.Error(w, `{"error": "database unavailable"}`, tc.status)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"keys": tc.keys})
+			}))
+			defer server.Close()
+
+			rawState := map[string]interface{}{
+				"id":        "search-service",
+				"key_alias": "search-service",
+				"team_id":   tc.teamID,
+			}
+			got, err := resourceKeyStateUpgradeV0(context.Background(), rawState, client.NewClient("sk-admin", server.URL))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got state %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got["id"] != tc.wantID {
+				t.Errorf("id = %v, want %s", got["id"], tc.wantID)
+			}
+			if tc.wantID != "search-service" && got["token"] != tc.wantID {
+				t.Errorf("token = %v, want %s", got["token"], tc.wantID)
+			}
+		})
+	}
+}
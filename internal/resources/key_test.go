@@ -1,4 +1,4 @@
-package resources
+package resources_test
 
 import (
 	"fmt"
@@ -9,6 +9,8 @@ import (
 )
 
 func TestAccResourceKey_basic(t *testing.T) {
+	var token string
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { provider.TestAccPreCheck(t) },
 		ProviderFactories: provider.TestAccProviderFactories,
@@ -22,9 +24,14 @@ func TestAccResourceKey_basic(t *testing.T) {
 						"litellm_key.test", "team_id", "test-team"),
 					resource.TestCheckResourceAttr(
 						"litellm_key.test", "max_budget", "100"),
+					resource.TestCheckResourceAttrWith(
+						"litellm_key.test", "id", func(value string) error {
+							token = value
+							return nil
+						}),
 				),
 			},
-			// Test update
+			// Renaming the alias updates the key in place
 			{
 				Config: testAccResourceKeyConfig_update(),
 				Check: resource.ComposeTestCheckFunc(
@@ -34,13 +41,31 @@ func TestAccResourceKey_basic(t *testing.T) {
 						"litellm_key.test", "team_id", "test-team-2"),
 					resource.TestCheckResourceAttr(
 						"litellm_key.test", "max_budget", "200"),
+					resource.TestCheckResourceAttrWith(
+						"litellm_key.test", "id", func(value string) error {
+							if value != token {
+								return fmt.Errorf("expected key %s to be updated in place, got %s", token, value)
+							}
+							return nil
+						}),
+					resource.TestCheckResourceAttrPair(
+						"litellm_key.test", "id", "litellm_key.test", "token"),
 				),
 			},
 			// Import test
 			{
-				ResourceName:      "litellm_key.test",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "litellm_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "last_rotated_at"},
+			},
+			// Import by key alias
+			{
+				ResourceName:            "litellm_key.test",
+				ImportState:             true,
+				ImportStateId:           "test-key-updated",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key", "last_rotated_at"},
 			},
 		},
 	})
@@ -112,3 +137,268 @@ resource "litellm_key" "full" {
 }
 `)
 }
+
+func TestAccResourceKey_blocked(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKeyConfig_blocked(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.blocked", "blocked", "false"),
+				),
+			},
+			// Block the key in place
+			{
+				Config: testAccResourceKeyConfig_blocked(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.blocked", "blocked", "true"),
+					resource.TestCheckResourceAttr(
+						"data.litellm_key.blocked", "blocked", "true"),
+				),
+			},
+			// Unblock it again
+			{
+				Config: testAccResourceKeyConfig_blocked(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.blocked", "blocked", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceKeyConfig_blocked(blocked bool) string {
+	return fmt.Sprintf(`
+resource "litellm_key" "blocked" {
+  key_alias = "blocked-test-key"
+  team_id   = "test-team"
+  blocked   = %t
+}
+
+data "litellm_key" "blocked" {
+  key_alias = litellm_key.blocked.key_alias
+}
+`, blocked)
+}
+
+func TestAccResourceKey_rotation(t *testing.T) {
+	var firstKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKeyConfig_rotation("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"litellm_key.rotated", "last_rotated_at"),
+					resource.TestCheckResourceAttrWith(
+						"litellm_key.rotated", "key", func(value string) error {
+							firstKey = value
+							return nil
+						}),
+				),
+			},
+			// Changing a trigger regenerates the key in place
+			{
+				Config: testAccResourceKeyConfig_rotation("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.rotated", "key_alias", "rotated-test-key"),
+					resource.TestCheckResourceAttrWith(
+						"litellm_key.rotated", "key", func(value string) error {
+							if value == firstKey {
+								return fmt.Errorf("expected key to change after rotation")
+							}
+							return nil
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceKeyConfig_rotation(generation string) string {
+	return fmt.Sprintf(`
+resource "litellm_key" "rotated" {
+  key_alias       = "rotated-test-key"
+  team_id         = "test-team"
+  rotation_period = "30d"
+  rotation_triggers = {
+    generation = %q
+  }
+}
+`, generation)
+}
+
+func TestAccResourceKey_generateRequest(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKeyConfig_generateRequest(10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "user_id", "test-user"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "soft_budget", "50"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "budget_duration", "30d"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "tpm_limit", "10000"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "rpm_limit", "10"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "max_parallel_requests", "5"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "aliases.fast", "gpt-3.5-turbo"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "permissions.get_spend_routes", "true"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "model_max_budget.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"litellm_key.generate", "model_max_budget.*", map[string]string{
+							"model":           "gpt-4",
+							"max_budget":      "20",
+							"budget_duration": "7d",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"litellm_key.generate", "model_rpm_limit.*", map[string]string{
+							"model": "gpt-4",
+							"limit": "10",
+						}),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"litellm_key.generate", "model_tpm_limit.*", map[string]string{
+							"model": "gpt-4",
+							"limit": "1000",
+						}),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "allowed_cache_controls.0", "no-cache"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "enforced_params.0", "user"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "allowed_routes.0", "/chat/completions"),
+					resource.TestCheckResourceAttr(
+						"data.litellm_key.generate", "rpm_limit", "10"),
+					resource.TestCheckResourceAttr(
+						"data.litellm_key.generate", "model_rpm_limit.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"litellm_key.generate", "token"),
+					resource.TestCheckResourceAttrSet(
+						"litellm_key.generate", "created_at"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "spend", "0"),
+					resource.TestCheckResourceAttrPair(
+						"data.litellm_key.generate", "token", "litellm_key.generate", "token"),
+				),
+			},
+			// Per-model limits update in place
+			{
+				Config: testAccResourceKeyConfig_generateRequest(20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.generate", "rpm_limit", "20"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"litellm_key.generate", "model_rpm_limit.*", map[string]string{
+							"model": "gpt-4",
+							"limit": "20",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceKeyConfig_generateRequest(rpm int) string {
+	return fmt.Sprintf(`
+resource "litellm_key" "generate" {
+  key_alias             = "generate-test-key"
+  team_id               = "test-team"
+  user_id               = "test-user"
+  models                = ["gpt-4", "gpt-3.5-turbo"]
+  duration              = "90d"
+  soft_budget           = 50
+  budget_duration       = "30d"
+  tpm_limit             = 10000
+  rpm_limit             = %[1]d
+  max_parallel_requests = 5
+
+  aliases = {
+    fast = "gpt-3.5-turbo"
+  }
+
+  permissions = {
+    get_spend_routes = true
+  }
+
+  model_max_budget {
+    model           = "gpt-4"
+    max_budget      = 20
+    budget_duration = "7d"
+  }
+
+  model_rpm_limit {
+    model = "gpt-4"
+    limit = %[1]d
+  }
+
+  model_tpm_limit {
+    model = "gpt-4"
+    limit = 1000
+  }
+
+  allowed_cache_controls = ["no-cache"]
+  enforced_params        = ["user"]
+  allowed_routes         = ["/chat/completions"]
+}
+
+data "litellm_key" "generate" {
+  key_alias = litellm_key.generate.key_alias
+}
+`, rpm)
+}
+
+func TestAccResourceKey_metadataJSON(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceKeyConfig_metadataJSON(4200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.metadata", "metadata_json", `{"cost_center":4200,"owners":["alice"]}`),
+					resource.TestCheckResourceAttr(
+						"litellm_key.metadata", "metadata.%", "0"),
+				),
+			},
+			{
+				Config: testAccResourceKeyConfig_metadataJSON(4300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_key.metadata", "metadata_json", `{"cost_center":4300,"owners":["alice"]}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceKeyConfig_metadataJSON(costCenter int) string {
+	return fmt.Sprintf(`
+resource "litellm_key" "metadata" {
+  key_alias = "metadata-test-key"
+  team_id   = "test-team"
+  metadata_json = jsonencode({
+    cost_center = %d
+    owners      = ["alice"]
+  })
+}
+`, costCenter)
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+// Metadata is free-form JSON on the proxy. The `metadata` map covers the
+// common case of string values; `metadata_json` holds anything else.
+
+func metadataSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeMap,
+		Optional:      true,
+		Elem:          &schema.Schema{Type: schema.TypeString},
+		ConflictsWith: []string{"metadata_json"},
+		Description:   description + ". Use `metadata_json` for non-string values",
+	}
+}
+
+func metadataJSONSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ConflictsWith:    []string{"metadata"},
+		ValidateFunc:     validation.StringIsJSONObject,
+		StateFunc:        normalizeJSONState,
+		DiffSuppressFunc: suppressEquivalentJSON,
+		Description:      description + " as a JSON object, for nested or non-string values",
+	}
+}
+
+// expandMetadata returns the metadata from whichever of `metadata` and
+// `metadata_json` is set.
+func expandMetadata(d *schema.ResourceData) map[string]interface{} {
+	metadata := make(map[string]interface{})
+	if v, ok := d.GetOk("metadata_json"); ok {
+		// Already validated as a JSON object
+		decodeJSON(v.(string), &metadata)
+		return metadata
+	}
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v
+	}
+	return metadata
+}
+
+// flattenMetadata sets `metadata_json` if it is already in use or the
+// metadata has non-string values, and `metadata` otherwise. The other
+// attribute is left unset so generated configuration doesn't set both.
+func flattenMetadata(d *schema.ResourceData, metadata map[string]interface{}) error {
+	strs, encoded, err := FlattenMetadata(metadata)
+	if err != nil {
+		return err
+	}
+
+	if _, inUse := d.GetOk("metadata_json"); inUse || len(strs) != len(metadata) {
+		return d.Set("metadata_json", encoded)
+	}
+
+	return d.Set("metadata", strs)
+}
+
+// FlattenMetadata returns the string values of metadata, for `metadata`, and
+// the whole object as JSON, for `metadata_json`. The data sources set both.
+func FlattenMetadata(metadata map[string]interface{}) (map[string]string, string, error) {
+	strs := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		if s, ok := v.(string); ok {
+			strs[k] = s
+		}
+	}
+
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return strs, string(encoded), nil
+}
+
+// normalizeJSON re-encodes a JSON document with sorted keys and no
+// insignificant whitespace. Numbers are kept as written, so large integers
+// survive; suppressEquivalentJSON compares them by value.
+func normalizeJSON(s string) (string, error) {
+	var v interface{}
+	if err := decodeJSON(s, &v); err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func normalizeJSONState(v interface{}) string {
+	s := v.(string)
+	normalized, err := normalizeJSON(s)
+	if err != nil {
+		return s
+	}
+	return normalized
+}
+
+// suppressEquivalentJSON compares the decoded documents, so key order,
+// whitespace and the spelling of numbers (1.0 and 1, 1e3 and 1000) don't
+// cause a diff. The proxy returns numbers in its own spelling.
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+func decodeJSON(s string, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.UseNumber()
+	return dec.Decode(v)
+}
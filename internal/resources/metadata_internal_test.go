@@ -0,0 +1,117 @@
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestNormalizeJSON(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{`{"b": 1, "a": {"d": [1, 2], "c": null}}`, `{"a":{"c":null,"d":[1,2]},"b":1}`},
+		{`{"big": 12345678901234567890}`, `{"big":12345678901234567890}`},
+		{`{"n": 1.0}`, `{"n":1.0}`},
+	}
+	for _, tc := range cases {
+		got, err := normalizeJSON(tc.in)
+		if err != nil {
+			t.Errorf("normalizeJSON(%q) error = %v", tc.in, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("normalizeJSON(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+
+	if _, err := normalizeJSON(`{"a":`); err == nil {
+		t.Error("normalizeJSON accepted invalid JSON")
+	}
+}
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	cases := []struct {
+		name     string
+		old, new string
+		want     bool
+	}{
+		{"key order", `{"a":1,"b":"x"}`, `{"b": "x", "a": 1}`, true},
+		{"nested key order", `{"o":{"a":1,"b":2}}`, `{"o":{"b":2,"a":1}}`, true},
+		{"decimal point", `{"n":1}`, `{"n":1.0}`, true},
+		{"exponent", `{"n":1000}`, `{"n":1e3}`, true},
+		{"different number", `{"n":1}`, `{"n":2}`, false},
+		{"number and string", `{"n":1}`, `{"n":"1"}`, false},
+		{"array order", `{"l":[1,2]}`, `{"l":[2,1]}`, false},
+		{"added key", `{"a":1}`, `{"a":1,"b":2}`, false},
+		{"invalid", `{"a":1}`, `{"a":`, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := suppressEquivalentJSON("metadata_json", tc.old, tc.new, nil); got != tc.want {
+				t.Errorf("suppressEquivalentJSON(%q, %q) = %t, want %t", tc.old, tc.new, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFlattenMetadata(t *testing.T) {
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		metadata map[string]interface{}
+		wantMap  map[string]interface{}
+		wantJSON string
+	}{
+		{
+			name:     "string values",
+			metadata: map[string]interface{}{"owner": "search"},
+			wantMap:  map[string]interface{}{"owner": "search"},
+		},
+		{
+			name:     "non-string values",
+			metadata: map[string]interface{}{"owner": "search", "cost_center": 4200.0},
+			wantMap:  map[string]interface{}{},
+			wantJSON: `{"cost_center":4200,"owner":"search"}`,
+		},
+		{
+			name:     "metadata_json in use",
+			config:   map[string]interface{}{"metadata_json": `{"owner": "search"}`},
+			metadata: map[string]interface{}{"owner": "search"},
+			wantMap:  map[string]interface{}{},
+			wantJSON: `{"owner":"search"}`,
+		},
+		{
+			name:     "metadata_json cleared on the proxy",
+			config:   map[string]interface{}{"metadata_json": `{"owner": "search"}`},
+			metadata: nil,
+			wantMap:  map[string]interface{}{},
+			wantJSON: `{}`,
+		},
+		{
+			name:    "no metadata",
+			wantMap: map[string]interface{}{},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := tc.config
+			if config == nil {
+				config = map[string]interface{}{}
+			}
+			d := schema.TestResourceDataRaw(t, ResourceModel().Schema, config)
+
+			if err := flattenMetadata(d, tc.metadata); err != nil {
+				t.Fatalf("flattenMetadata() error = %v", err)
+			}
+
+			if got := d.Get("metadata"); !reflect.DeepEqual(got, tc.wantMap) {
+				t.Errorf("metadata = %v, want %v", got, tc.wantMap)
+			}
+			if got := d.Get("metadata_json"); got != tc.wantJSON {
+				t.Errorf("metadata_json = %q, want %q", got, tc.wantJSON)
+			}
+		})
+	}
+}
@@ -2,9 +2,12 @@ package resources
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/catalog"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
 	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
 )
@@ -15,79 +18,512 @@ func ResourceModel() *schema.Resource {
 		ReadContext:   resourceModelRead,
 		UpdateContext: resourceModelUpdate,
 		DeleteContext: resourceModelDelete,
+		CustomizeDiff: resourceModelCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceModelImport,
+		},
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "The name of the model",
-				ValidateFunc: validation.StringMinLength(3),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceModelV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceModelStateUpgradeV0,
 			},
-			"model_provider": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The provider of the model (e.g., 'openai', 'anthropic')",
-				ValidateFunc: validation.OneOf(
-					"openai",
-					"anthropic",
-					"azure",
-					"cohere",
-					"google",
-					"replicate",
-					"huggingface",
-				),
+		},
+
+		Schema: resourceModelSchema(),
+	}
+}
+
+func resourceModelSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The public model name clients request. Deployments that share a name are load balanced",
+			ValidateFunc: validation.StringMinLength(3),
+		},
+		"model_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The deployment's `model_info.id`, used as the resource ID. Generated by the proxy unless set",
+		},
+		"model_provider": {
+			Type:     schema.TypeString,
+			Required: true,
+			Description: "The LiteLLM provider of the model (e.g. 'openai', 'bedrock', 'vertex_ai'). " +
+				"Aliases such as 'vertex' or 'azure_openai' are accepted and sent as the canonical name",
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				oldProvider, _ := catalog.Canonical(old)
+				newProvider, _ := catalog.Canonical(new)
+				return oldProvider == newProvider
 			},
-			"model_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the underlying model",
+		},
+		"model_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the underlying model. For Azure this is the deployment name",
+		},
+		"api_base": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The base URL for API calls",
+		},
+		"api_key": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			Description:   "API key for the model provider",
+			ConflictsWith: []string{"api_key_env", "litellm_credential_name"},
+		},
+		"api_key_env": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Environment variable on the proxy host holding the API key. Sent as `os.environ/<name>`, so the key never enters Terraform state",
+			ValidateFunc:  validation.EnvVarName,
+			ConflictsWith: []string{"api_key", "litellm_credential_name"},
+		},
+		"api_key_fingerprint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Salted SHA-256 fingerprint of the API key last sent. Cleared when the key on the proxy no longer matches, so the next apply sends it again",
+		},
+		"cloud_secrets_fingerprint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Salted SHA-256 fingerprint of the secrets in the `aws`, `vertex` and `azure` blocks last sent. Cleared when the secrets on the proxy no longer match, so the next apply sends them again",
+		},
+		"litellm_credential_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Name of a `litellm_credential` to authenticate with instead of `api_key`",
+			ConflictsWith: []string{"api_key", "api_key_env"},
+		},
+		"api_version": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "API version to call. For Azure, set `api_version` in the `azure` block instead",
+			ConflictsWith: []string{"azure"},
+		},
+		"rpm": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Requests per minute this deployment can handle, used for load balancing",
+			ValidateFunc: validation.IntGreaterThanOrEqual(0),
+		},
+		"tpm": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Tokens per minute this deployment can handle, used for load balancing",
+			ValidateFunc: validation.IntGreaterThanOrEqual(0),
+		},
+		"timeout": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Request timeout in seconds",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"stream_timeout": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Timeout in seconds for streaming requests",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"max_retries": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Number of times a failed request is retried",
+			ValidateFunc: validation.IntGreaterThanOrEqual(0),
+		},
+		"organization": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Organization ID sent to the provider (OpenAI)",
+		},
+		"region_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Provider region the deployment runs in",
+		},
+		"input_cost_per_token": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Custom cost in USD per input token",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"output_cost_per_token": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Custom cost in USD per output token",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"input_cost_per_second": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Custom cost in USD per second of input, for deployments billed by time",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"output_cost_per_second": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Custom cost in USD per second of output, for deployments billed by time",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"input_cost_per_pixel": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Custom cost in USD per input pixel, for image models",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"output_cost_per_pixel": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Custom cost in USD per output pixel, for image models",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"max_budget": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Maximum spend in USD on this deployment",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"budget_duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "How often spend on this deployment is reset (e.g. '30d')",
+			ValidateFunc: validation.Duration,
+		},
+		"use_in_pass_through": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Use this deployment's credentials for pass-through endpoints",
+		},
+		"merge_reasoning_content_in_choices": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Merge reasoning content into the response choices",
+		},
+		"max_file_size_mb": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Maximum size in MB of files sent to this deployment",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"model_info": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "Routing and reporting metadata sent as `model_info`",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "What the model is called for, e.g. 'chat', 'embedding' or 'rerank'. Used by health checks and routing",
+						ValidateFunc: validation.OneOf(
+							"chat",
+							"completion",
+							"embedding",
+							"image_generation",
+							"audio_transcription",
+							"audio_speech",
+							"moderation",
+							"rerank",
+							"batch",
+							"responses",
+						),
+					},
+					"base_model": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The model used for cost tracking when `model_name` is a custom deployment name, e.g. 'azure/gpt-4o'",
+					},
+					"tier": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Description:  "'free' or 'paid', used to route requests by user tier",
+						ValidateFunc: validation.OneOf("free", "paid"),
+					},
+					"access_groups": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Access groups the model belongs to. Keys and teams granted a group can call every model in it",
+					},
+					"team_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Team the model is scoped to. Only that team can call it",
+					},
+					"team_public_model_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Name the team sees the model under. Requires `team_id`",
+					},
+					"supports_vision": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether the model accepts image input",
+					},
+					"supports_function_calling": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether the model supports function calling",
+					},
+					"supports_parallel_function_calling": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether the model supports parallel function calls",
+					},
+					"supports_response_schema": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether the model supports structured output with a response schema",
+					},
+				},
 			},
-			"api_base": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The base URL for API calls",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the model was added to the proxy",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the model was last updated",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Who added the model",
+		},
+		"db_model": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the model is stored in the proxy database, rather than in its config file",
+		},
+		"order": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Description:  "Priority of this deployment among those sharing `name`. Lower orders are tried first",
+			ValidateFunc: validation.IntGreaterThanOrEqual(1),
+		},
+		"weight": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Description:  "Relative share of traffic this deployment receives under simple-shuffle routing",
+			ValidateFunc: validation.FloatGreaterThanOrEqual(0),
+		},
+		"aws": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "AWS credentials for `bedrock` and `sagemaker` models",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"aws_access_key_id": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						Description:   "Access key ID. Must be set together with `aws_secret_access_key`",
+						ConflictsWith: []string{"aws.0.aws_access_key_id_env"},
+					},
+					"aws_access_key_id_env": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Description:   "Environment variable on the proxy host holding `aws_access_key_id`, sent as an `os.environ/` reference",
+						ValidateFunc:  validation.EnvVarName,
+						ConflictsWith: []string{"aws.0.aws_access_key_id"},
+					},
+					"aws_secret_access_key": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						Description:   "Secret access key",
+						ConflictsWith: []string{"aws.0.aws_secret_access_key_env"},
+					},
+					"aws_secret_access_key_env": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Description:   "Environment variable on the proxy host holding `aws_secret_access_key`, sent as an `os.environ/` reference",
+						ValidateFunc:  validation.EnvVarName,
+						ConflictsWith: []string{"aws.0.aws_secret_access_key"},
+					},
+					"aws_session_token": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						Description:   "Session token for temporary credentials",
+						ConflictsWith: []string{"aws.0.aws_session_token_env"},
+					},
+					"aws_session_token_env": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Description:   "Environment variable on the proxy host holding `aws_session_token`, sent as an `os.environ/` reference",
+						ValidateFunc:  validation.EnvVarName,
+						ConflictsWith: []string{"aws.0.aws_session_token"},
+					},
+					"aws_region_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "AWS region the model is called in, e.g. 'us-east-1'",
+						ValidateFunc: validation.StringNotEmpty,
+					},
+					"aws_role_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "ARN of a role to assume instead of, or in addition to, static credentials",
+					},
+					"aws_session_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Session name used when assuming `aws_role_name`",
+					},
+				},
 			},
-			"api_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Sensitive:   true,
-				Description: "API key for the model provider",
+		},
+		"vertex": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Google Cloud settings for `vertex_ai` models",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"vertex_project": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Google Cloud project ID",
+						ValidateFunc: validation.StringNotEmpty,
+					},
+					"vertex_location": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Region the model is called in, e.g. 'us-central1'",
+						ValidateFunc: validation.StringNotEmpty,
+					},
+					"vertex_credentials": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						Description:   "Service account key JSON. If unset, the proxy's default credentials are used",
+						ValidateFunc:  validation.StringIsJSON,
+						ConflictsWith: []string{"vertex.0.vertex_credentials_env"},
+					},
+					"vertex_credentials_env": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Description:   "Environment variable on the proxy host holding `vertex_credentials`, sent as an `os.environ/` reference",
+						ValidateFunc:  validation.EnvVarName,
+						ConflictsWith: []string{"vertex.0.vertex_credentials"},
+					},
+				},
 			},
-			"metadata": {
-				Type:        schema.TypeMap,
-				Optional:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "Additional metadata for the model",
+		},
+		"azure": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Azure OpenAI settings for `azure` models. The deployment name goes in `model_name`",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_version": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "Azure OpenAI API version, e.g. '2024-02-01'",
+						ValidateFunc: validation.StringNotEmpty,
+					},
+					"azure_ad_token": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						Description:   "Entra ID token to authenticate with instead of `api_key`",
+						ConflictsWith: []string{"azure.0.azure_ad_token_env"},
+					},
+					"azure_ad_token_env": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Description:   "Environment variable on the proxy host holding `azure_ad_token`, sent as an `os.environ/` reference",
+						ValidateFunc:  validation.EnvVarName,
+						ConflictsWith: []string{"azure.0.azure_ad_token"},
+					},
+					"tenant_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Entra ID tenant for service principal authentication",
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Service principal client ID. Must be set together with `tenant_id` and `client_secret`",
+					},
+					"client_secret": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						Description:   "Service principal client secret",
+						ConflictsWith: []string{"azure.0.client_secret_env"},
+					},
+					"client_secret_env": {
+						Type:          schema.TypeString,
+						Optional:      true,
+						Description:   "Environment variable on the proxy host holding `client_secret`, sent as an `os.environ/` reference",
+						ValidateFunc:  validation.EnvVarName,
+						ConflictsWith: []string{"azure.0.client_secret"},
+					},
+				},
 			},
 		},
+		"watsonx": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "IBM watsonx.ai settings for `watsonx` models",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"watsonx_region_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						Description:  "watsonx.ai region, e.g. 'us-south'",
+						ValidateFunc: validation.StringNotEmpty,
+					},
+					"project_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "watsonx.ai project ID",
+					},
+				},
+			},
+		},
+		"metadata":      metadataSchema("Additional metadata for the model"),
+		"metadata_json": metadataJSONSchema("Additional metadata for the model"),
 	}
 }
 
 func resourceModelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
-	model := &client.Model{
-		Name:          d.Get("name").(string),
-		ModelProvider: d.Get("model_provider").(string),
-		ModelName:     d.Get("model_name").(string),
-		APIBase:       d.Get("api_base").(string),
-		APIKey:        d.Get("api_key").(string),
-	}
-
-	if v, ok := d.GetOk("metadata"); ok {
-		metadata := make(map[string]string)
-		for k, v := range v.(map[string]interface{}) {
-			metadata[k] = v.(string)
-		}
-		model.Metadata = metadata
-	}
+	model := expandModel(d)
+	model.ModelInfo.ID = d.Get("model_id").(string)
 
 	if err := c.CreateModel(model); err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(model.Name)
+	d.SetId(model.ModelInfo.ID)
+
+	if diags := setModelFingerprints(d, model); diags.HasError() {
+		return diags
+	}
 
 	return resourceModelRead(ctx, d, m)
 }
@@ -106,11 +542,58 @@ func resourceModelRead(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 
 	d.Set("name", model.Name)
+	d.Set("model_id", model.ModelInfo.ID)
 	d.Set("model_provider", model.ModelProvider)
 	d.Set("model_name", model.ModelName)
 	d.Set("api_base", model.APIBase)
-	d.Set("metadata", model.Metadata)
-	// Don't set api_key as it's sensitive and not returned by the API
+	d.Set("litellm_credential_name", model.LiteLLMCredentialName)
+	if err := flattenMetadata(d, model.Metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("api_version", model.APIVersion)
+	d.Set("rpm", model.RPM)
+	d.Set("tpm", model.TPM)
+	d.Set("timeout", model.Timeout)
+	d.Set("stream_timeout", model.StreamTimeout)
+	d.Set("max_retries", model.MaxRetries)
+	d.Set("organization", model.Organization)
+	d.Set("region_name", model.RegionName)
+	d.Set("input_cost_per_token", model.InputCostPerToken)
+	d.Set("output_cost_per_token", model.OutputCostPerToken)
+	d.Set("input_cost_per_second", model.InputCostPerSecond)
+	d.Set("output_cost_per_second", model.OutputCostPerSecond)
+	d.Set("input_cost_per_pixel", model.InputCostPerPixel)
+	d.Set("output_cost_per_pixel", model.OutputCostPerPixel)
+	d.Set("max_budget", model.MaxBudget)
+	d.Set("budget_duration", model.BudgetDuration)
+	d.Set("use_in_pass_through", model.UseInPassThrough)
+	d.Set("merge_reasoning_content_in_choices", model.MergeReasoningContentInChoices)
+	d.Set("max_file_size_mb", model.MaxFileSizeMB)
+	d.Set("order", model.Order)
+	d.Set("weight", model.Weight)
+	d.Set("model_info", flattenModelInfo(model.ModelInfo))
+	d.Set("created_at", model.ModelInfo.CreatedAt)
+	d.Set("updated_at", model.ModelInfo.UpdatedAt)
+	d.Set("created_by", model.ModelInfo.CreatedBy)
+	d.Set("db_model", model.ModelInfo.DBModel)
+	flattenModelCloudBlocks(d, model)
+	// api_key itself is not returned by the API, but an os.environ/ reference is
+	if env, ok := envReferenceName(model.APIKey); ok {
+		d.Set("api_key_env", env)
+	}
+
+	// Clearing a fingerprint makes the next plan send its secrets again.
+	sent := secretValue(d.Get("api_key").(string), d.Get("api_key_env").(string))
+	if sent != "" && !maskedValueMatches(model.APIKey, sent) {
+		d.Set("api_key_fingerprint", "")
+	}
+	returned := returnedCloudSecrets(model)
+	for field, sent := range modelCloudSecrets(d.Get) {
+		if !maskedValueMatches(returned[field], sent) {
+			d.Set("cloud_secrets_fingerprint", "")
+			break
+		}
+	}
 
 	return nil
 }
@@ -118,26 +601,17 @@ func resourceModelRead(ctx context.Context, d *schema.ResourceData, m interface{
 func resourceModelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	c := m.(*client.Client)
 
-	model := &client.Model{
-		Name:          d.Id(),
-		ModelProvider: d.Get("model_provider").(string),
-		ModelName:     d.Get("model_name").(string),
-		APIBase:       d.Get("api_base").(string),
-		APIKey:        d.Get("api_key").(string),
-	}
-
-	if v, ok := d.GetOk("metadata"); ok {
-		metadata := make(map[string]string)
-		for k, v := range v.(map[string]interface{}) {
-			metadata[k] = v.(string)
-		}
-		model.Metadata = metadata
-	}
+	model := expandModel(d)
+	model.ModelInfo.ID = d.Id()
 
 	if err := c.UpdateModel(model); err != nil {
 		return diag.FromErr(err)
 	}
 
+	if diags := setModelFingerprints(d, model); diags.HasError() {
+		return diags
+	}
+
 	return resourceModelRead(ctx, d, m)
 }
 
@@ -152,3 +626,437 @@ func resourceModelDelete(ctx context.Context, d *schema.ResourceData, m interfac
 
 	return nil
 }
+
+func setModelFingerprints(d *schema.ResourceData, model *client.Model) diag.Diagnostics {
+	fingerprint, err := updateSecretFingerprint(d.Get("api_key_fingerprint").(string), model.APIKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("api_key_fingerprint", fingerprint)
+
+	fingerprint, err = updateSecretFingerprint(d.Get("cloud_secrets_fingerprint").(string), secretMapValue(modelCloudSecrets(d.Get)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("cloud_secrets_fingerprint", fingerprint)
+
+	return nil
+}
+
+// modelCloudSecretFields lists the secrets in each cloud block. Each also has
+// a _env variant.
+var modelCloudSecretFields = map[string][]string{
+	"aws":    {"aws_access_key_id", "aws_secret_access_key", "aws_session_token"},
+	"vertex": {"vertex_credentials"},
+	"azure":  {"azure_ad_token", "client_secret"},
+}
+
+// modelCloudSecrets returns the secrets set in the cloud blocks, keyed by
+// field, as they are sent to the proxy. get is the Get of a ResourceData or
+// ResourceDiff.
+func modelCloudSecrets(get func(string) interface{}) map[string]string {
+	secrets := make(map[string]string)
+	for block, fields := range modelCloudSecretFields {
+		v := get(block).([]interface{})
+		if len(v) != 1 || v[0] == nil {
+			continue
+		}
+		b := v[0].(map[string]interface{})
+		for _, field := range fields {
+			if value := secretValue(b[field].(string), b[field+"_env"].(string)); value != "" {
+				secrets[field] = value
+			}
+		}
+	}
+	return secrets
+}
+
+// returnedCloudSecrets returns the cloud secrets of a model read from the
+// proxy, keyed like modelCloudSecrets.
+func returnedCloudSecrets(model *client.Model) map[string]string {
+	return map[string]string{
+		"aws_access_key_id":     model.AWSAccessKeyID,
+		"aws_secret_access_key": model.AWSSecretAccessKey,
+		"aws_session_token":     model.AWSSessionToken,
+		"vertex_credentials":    model.VertexCredentials,
+		"azure_ad_token":        model.AzureADToken,
+		"client_secret":         model.ClientSecret,
+	}
+}
+
+// resourceModelV0 is the schema from before the ID switched from name to
+// model_info.id. It only needs the attribute types to decode old state, so
+// validation is left out.
+func resourceModelV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"model_provider": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"model_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"api_base": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"api_key": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceModelStateUpgradeV0 replaces a name ID with the model_info.id of
+// the deployment with that name.
+func resourceModelStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	c := m.(*client.Client)
+
+	name, _ := rawState["id"].(string)
+	if name == "" {
+		return rawState, nil
+	}
+
+	models, err := c.ListModels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up model %s while upgrading state: %w", name, err)
+	}
+
+	var matches []client.Model
+	for _, model := range models {
+		if model.Name == name {
+			matches = append(matches, model)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		// The model is gone; leave the ID so the next refresh removes it.
+		return rawState, nil
+	case 1:
+		rawState["id"] = matches[0].ModelInfo.ID
+		rawState["model_id"] = matches[0].ModelInfo.ID
+		return rawState, nil
+	}
+
+	return nil, fmt.Errorf("found %d deployments named %s; remove the model from state and import it by its model ID", len(matches), name)
+}
+
+// resourceModelImport accepts a deployment's model ID, or a model name that
+// only one deployment uses.
+func resourceModelImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.Client)
+
+	model, err := c.GetModel(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if model != nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	models, err := c.ListModels()
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, model := range models {
+		if model.Name == d.Id() {
+			ids = append(ids, model.ModelInfo.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("no model with ID or name %s found", d.Id())
+	case 1:
+		d.SetId(ids[0])
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("found %d deployments named %s; import one by its model ID: %s", len(ids), d.Id(), strings.Join(ids, ", "))
+}
+
+// expandModel builds the litellm_params shared by model creation and update.
+func expandModel(d *schema.ResourceData) *client.Model {
+	provider, _ := catalog.Canonical(d.Get("model_provider").(string))
+
+	model := &client.Model{
+		Name:                           d.Get("name").(string),
+		ModelProvider:                  provider,
+		ModelName:                      d.Get("model_name").(string),
+		APIBase:                        d.Get("api_base").(string),
+		APIKey:                         secretValue(d.Get("api_key").(string), d.Get("api_key_env").(string)),
+		LiteLLMCredentialName:          d.Get("litellm_credential_name").(string),
+		Metadata:                       expandMetadata(d),
+		APIVersion:                     d.Get("api_version").(string),
+		RPM:                            d.Get("rpm").(int),
+		TPM:                            d.Get("tpm").(int),
+		Timeout:                        d.Get("timeout").(float64),
+		StreamTimeout:                  d.Get("stream_timeout").(float64),
+		MaxRetries:                     d.Get("max_retries").(int),
+		Organization:                   d.Get("organization").(string),
+		RegionName:                     d.Get("region_name").(string),
+		InputCostPerToken:              d.Get("input_cost_per_token").(float64),
+		OutputCostPerToken:             d.Get("output_cost_per_token").(float64),
+		InputCostPerSecond:             d.Get("input_cost_per_second").(float64),
+		OutputCostPerSecond:            d.Get("output_cost_per_second").(float64),
+		InputCostPerPixel:              d.Get("input_cost_per_pixel").(float64),
+		OutputCostPerPixel:             d.Get("output_cost_per_pixel").(float64),
+		MaxBudget:                      d.Get("max_budget").(float64),
+		BudgetDuration:                 d.Get("budget_duration").(string),
+		UseInPassThrough:               d.Get("use_in_pass_through").(bool),
+		MergeReasoningContentInChoices: d.Get("merge_reasoning_content_in_choices").(bool),
+		MaxFileSizeMB:                  d.Get("max_file_size_mb").(float64),
+		Order:                          d.Get("order").(int),
+		Weight:                         d.Get("weight").(float64),
+	}
+
+	if v := d.Get("model_info").([]interface{}); len(v) == 1 && v[0] != nil {
+		info := v[0].(map[string]interface{})
+		model.ModelInfo = client.ModelInfo{
+			Mode:                            info["mode"].(string),
+			BaseModel:                       info["base_model"].(string),
+			Tier:                            info["tier"].(string),
+			AccessGroups:                    expandStringList(info["access_groups"].([]interface{})),
+			TeamID:                          info["team_id"].(string),
+			TeamPublicModelName:             info["team_public_model_name"].(string),
+			SupportsVision:                  info["supports_vision"].(bool),
+			SupportsFunctionCalling:         info["supports_function_calling"].(bool),
+			SupportsParallelFunctionCalling: info["supports_parallel_function_calling"].(bool),
+			SupportsResponseSchema:          info["supports_response_schema"].(bool),
+		}
+	}
+
+	if v := d.Get("aws").([]interface{}); len(v) == 1 && v[0] != nil {
+		aws := v[0].(map[string]interface{})
+		model.AWSAccessKeyID = secretValue(aws["aws_access_key_id"].(string), aws["aws_access_key_id_env"].(string))
+		model.AWSSecretAccessKey = secretValue(aws["aws_secret_access_key"].(string), aws["aws_secret_access_key_env"].(string))
+		model.AWSSessionToken = secretValue(aws["aws_session_token"].(string), aws["aws_session_token_env"].(string))
+		model.AWSRegionName = aws["aws_region_name"].(string)
+		model.AWSRoleName = aws["aws_role_name"].(string)
+		model.AWSSessionName = aws["aws_session_name"].(string)
+	}
+
+	if v := d.Get("vertex").([]interface{}); len(v) == 1 && v[0] != nil {
+		vertex := v[0].(map[string]interface{})
+		model.VertexProject = vertex["vertex_project"].(string)
+		model.VertexLocation = vertex["vertex_location"].(string)
+		model.VertexCredentials = secretValue(vertex["vertex_credentials"].(string), vertex["vertex_credentials_env"].(string))
+	}
+
+	if v := d.Get("azure").([]interface{}); len(v) == 1 && v[0] != nil {
+		azure := v[0].(map[string]interface{})
+		model.APIVersion = azure["api_version"].(string)
+		model.AzureADToken = secretValue(azure["azure_ad_token"].(string), azure["azure_ad_token_env"].(string))
+		model.TenantID = azure["tenant_id"].(string)
+		model.ClientID = azure["client_id"].(string)
+		model.ClientSecret = secretValue(azure["client_secret"].(string), azure["client_secret_env"].(string))
+	}
+
+	if v := d.Get("watsonx").([]interface{}); len(v) == 1 && v[0] != nil {
+		watsonx := v[0].(map[string]interface{})
+		model.WatsonxRegionName = watsonx["watsonx_region_name"].(string)
+		model.ProjectID = watsonx["project_id"].(string)
+	}
+
+	return model
+}
+
+func flattenModelInfo(info client.ModelInfo) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                               info.Mode,
+			"base_model":                         info.BaseModel,
+			"tier":                               info.Tier,
+			"access_groups":                      info.AccessGroups,
+			"team_id":                            info.TeamID,
+			"team_public_model_name":             info.TeamPublicModelName,
+			"supports_vision":                    info.SupportsVision,
+			"supports_function_calling":          info.SupportsFunctionCalling,
+			"supports_parallel_function_calling": info.SupportsParallelFunctionCalling,
+			"supports_response_schema":           info.SupportsResponseSchema,
+		},
+	}
+}
+
+// flattenModelCloudBlocks reads back the cloud blocks. Secrets are not
+// returned by the API, so they are kept from state unless the proxy reports an
+// environment variable reference.
+func flattenModelCloudBlocks(d *schema.ResourceData, model *client.Model) {
+	if model.AWSRegionName != "" || len(d.Get("aws").([]interface{})) > 0 {
+		aws := map[string]interface{}{
+			"aws_region_name":  model.AWSRegionName,
+			"aws_role_name":    model.AWSRoleName,
+			"aws_session_name": model.AWSSessionName,
+		}
+		flattenSecret(d, aws, "aws", "aws_access_key_id", model.AWSAccessKeyID)
+		flattenSecret(d, aws, "aws", "aws_secret_access_key", model.AWSSecretAccessKey)
+		flattenSecret(d, aws, "aws", "aws_session_token", model.AWSSessionToken)
+		d.Set("aws", []interface{}{aws})
+	}
+
+	if model.VertexProject != "" || len(d.Get("vertex").([]interface{})) > 0 {
+		vertex := map[string]interface{}{
+			"vertex_project":  model.VertexProject,
+			"vertex_location": model.VertexLocation,
+		}
+		flattenSecret(d, vertex, "vertex", "vertex_credentials", model.VertexCredentials)
+		d.Set("vertex", []interface{}{vertex})
+	}
+
+	// api_version lives in the azure block when there is one, so it is not
+	// reported on the top-level attribute as well. Without any of the Entra ID
+	// settings, api_version alone is read into the top-level attribute.
+	azure := model.ModelProvider == "azure" &&
+		(model.TenantID != "" || model.ClientID != "" || model.ClientSecret != "" || model.AzureADToken != "")
+	if azure || len(d.Get("azure").([]interface{})) > 0 {
+		azure := map[string]interface{}{
+			"api_version": model.APIVersion,
+			"tenant_id":   model.TenantID,
+			"client_id":   model.ClientID,
+		}
+		flattenSecret(d, azure, "azure", "azure_ad_token", model.AzureADToken)
+		flattenSecret(d, azure, "azure", "client_secret", model.ClientSecret)
+		d.Set("azure", []interface{}{azure})
+		d.Set("api_version", "")
+	}
+
+	if model.WatsonxRegionName != "" || len(d.Get("watsonx").([]interface{})) > 0 {
+		watsonx := map[string]interface{}{
+			"watsonx_region_name": model.WatsonxRegionName,
+			"project_id":          model.ProjectID,
+		}
+		d.Set("watsonx", []interface{}{watsonx})
+	}
+}
+
+const envReferencePrefix = "os.environ/"
+
+// secretValue returns the value to send for a secret set either literally or
+// as the name of an environment variable on the proxy host.
+func secretValue(literal, env string) string {
+	if env != "" {
+		return envReferencePrefix + env
+	}
+	return literal
+}
+
+// envReferenceName returns the variable name of an os.environ/ reference.
+func envReferenceName(value string) (string, bool) {
+	if !strings.HasPrefix(value, envReferencePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(value, envReferencePrefix), true
+}
+
+// flattenSecret sets field and field_env in a cloud block read from the API.
+// An os.environ/ reference returned by the proxy is read back into field_env;
+// anything else is masked or omitted by the proxy, so both keep their state.
+func flattenSecret(d *schema.ResourceData, block map[string]interface{}, blockName, field, returned string) {
+	if env, ok := envReferenceName(returned); ok {
+		block[field] = ""
+		block[field+"_env"] = env
+		return
+	}
+	block[field] = d.Get(fmt.Sprintf("%s.0.%s", blockName, field))
+	block[field+"_env"] = d.Get(fmt.Sprintf("%s.0.%s_env", blockName, field))
+}
+
+// modelCloudBlockProviders lists the providers each cloud block applies to.
+var modelCloudBlockProviders = map[string][]string{
+	"aws":     {"bedrock", "sagemaker"},
+	"vertex":  {"vertex_ai"},
+	"azure":   {"azure"},
+	"watsonx": {"watsonx"},
+}
+
+// resourceModelCustomizeDiff checks model_provider against the provider
+// catalog, that cloud blocks match it, and that credentials which only work
+// together are set together. The catalog check runs here rather than in a
+// ValidateFunc so that providers registered from the proxy are accepted.
+func resourceModelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// Plan an update that re-sends the secrets when they no longer match their
+	// fingerprint, e.g. after they were changed on the proxy.
+	if d.Id() != "" && d.NewValueKnown("api_key") && d.NewValueKnown("api_key_env") {
+		configured := secretValue(d.Get("api_key").(string), d.Get("api_key_env").(string))
+		if !secretFingerprintMatches(d.Get("api_key_fingerprint").(string), configured) {
+			if err := d.SetNewComputed("api_key_fingerprint"); err != nil {
+				return err
+			}
+		}
+	}
+	if d.Id() != "" && d.NewValueKnown("aws") && d.NewValueKnown("vertex") && d.NewValueKnown("azure") {
+		configured := secretMapValue(modelCloudSecrets(d.Get))
+		if !secretFingerprintMatches(d.Get("cloud_secrets_fingerprint").(string), configured) {
+			if err := d.SetNewComputed("cloud_secrets_fingerprint"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.NewValueKnown("model_provider") {
+		if err := catalog.Validate(d.Get("model_provider").(string)); err != nil {
+			return err
+		}
+	}
+
+	provider, _ := catalog.Canonical(d.Get("model_provider").(string))
+
+	for block, providers := range modelCloudBlockProviders {
+		if len(d.Get(block).([]interface{})) == 0 || !d.NewValueKnown("model_provider") {
+			continue
+		}
+		allowed := false
+		for _, p := range providers {
+			if p == provider {
+				allowed = true
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("the %s block can only be used with model_provider %s, got %q", block, strings.Join(providers, " or "), provider)
+		}
+	}
+
+	if d.Get("model_info.0.team_public_model_name").(string) != "" && d.Get("model_info.0.team_id").(string) == "" && d.NewValueKnown("model_info") {
+		return fmt.Errorf("model_info.team_public_model_name requires model_info.team_id")
+	}
+
+	if d.NewValueKnown("aws") {
+		keyID := d.Get("aws.0.aws_access_key_id").(string) + d.Get("aws.0.aws_access_key_id_env").(string)
+		secret := d.Get("aws.0.aws_secret_access_key").(string) + d.Get("aws.0.aws_secret_access_key_env").(string)
+		sessionToken := d.Get("aws.0.aws_session_token").(string) + d.Get("aws.0.aws_session_token_env").(string)
+		if (keyID == "") != (secret == "") {
+			return fmt.Errorf("aws_access_key_id and aws_secret_access_key must be set together")
+		}
+		if sessionToken != "" && keyID == "" {
+			return fmt.Errorf("aws_session_token requires aws_access_key_id and aws_secret_access_key")
+		}
+	}
+
+	if d.NewValueKnown("azure") {
+		tenantID := d.Get("azure.0.tenant_id").(string)
+		clientID := d.Get("azure.0.client_id").(string)
+		clientSecret := d.Get("azure.0.client_secret").(string) + d.Get("azure.0.client_secret_env").(string)
+		if (tenantID != "" || clientID != "" || clientSecret != "") && (tenantID == "" || clientID == "" || clientSecret == "") {
+			return fmt.Errorf("tenant_id, client_id and client_secret must be set together")
+		}
+	}
+
+	return nil
+}
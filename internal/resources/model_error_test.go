@@ -0,0 +1 @@
+package resources_test
@@ -0,0 +1,323 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+func TestFlattenModelCloudBlocks_azure(t *testing.T) {
+	cases := []struct {
+		name        string
+		model       client.Model
+		wantAzure   bool
+		wantVersion string
+	}{
+		{
+			name: "service principal",
+			model: client.Model{
+				ModelProvider: "azure",
+				APIVersion:    "2024-02-01",
+				TenantID:      "tenant",
+				ClientID:      "client",
+				ClientSecret:  "os.environ/AZURE_CLIENT_SECRET",
+			},
+			wantAzure: true,
+		},
+		{
+			name: "entra id token",
+			model: client.Model{
+				ModelProvider: "azure",
+				APIVersion:    "2024-02-01",
+				AzureADToken:  "eyJ0****abcd",
+			},
+			wantAzure: true,
+		},
+		{
+			name: "api key only",
+			model: client.Model{
+				ModelProvider: "azure",
+				APIVersion:    "2024-02-01",
+			},
+			wantVersion: "2024-02-01",
+		},
+		{
+			name: "other provider",
+			model: client.Model{
+				ModelProvider: "openai",
+				APIVersion:    "2024-02-01",
+				TenantID:      "tenant",
+			},
+			wantVersion: "2024-02-01",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourceModel().Schema, map[string]interface{}{})
+			d.Set("api_version", tc.model.APIVersion)
+
+			flattenModelCloudBlocks(d, &tc.model)
+
+			azure := d.Get("azure").([]interface{})
+			if got := len(azure) > 0; got != tc.wantAzure {
+				t.Fatalf("azure block set = %v, want %v", got, tc.wantAzure)
+			}
+			if got := d.Get("api_version").(string); got != tc.wantVersion {
+				t.Errorf("api_version = %q, want %q", got, tc.wantVersion)
+			}
+			if !tc.wantAzure {
+				return
+			}
+
+			block := azure[0].(map[string]interface{})
+			for field, want := range map[string]string{
+				"api_version": tc.model.APIVersion,
+				"tenant_id":   tc.model.TenantID,
+				"client_id":   tc.model.ClientID,
+			} {
+				if block[field] != want {
+					t.Errorf("azure.0.%s = %v, want %q", field, block[field], want)
+				}
+			}
+		})
+	}
+
+	// The proxy reports secrets set as os.environ/ references as such
+	d := schema.TestResourceDataRaw(t, ResourceModel().Schema, map[string]interface{}{})
+	flattenModelCloudBlocks(d, &cases[0].model)
+	if got := d.Get("azure.0.client_secret_env"); got != "AZURE_CLIENT_SECRET" {
+		t.Errorf("azure.0.client_secret_env = %v, want AZURE_CLIENT_SECRET", got)
+	}
+}
+
+func TestResourceModelV0_decodesBaselineState(t *testing.T) {
+	// State as written by releases that identified models by name
+	state := []byte(`{
+		"id": "gpt-4o",
+		"name": "gpt-4o",
+		"model_provider": "openai",
+		"model_name": "gpt-4o",
+		"api_base": "",
+		"api_key": "sk-1234",
+		"metadata": {"owner": "search"}
+	}`)
+
+	if _, err := ctyjson.Unmarshal(state, resourceModelV0().CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatalf("baseline state does not match the v0 schema: %v", err)
+	}
+}
+
+func TestResourceModelStateUpgradeV0(t *testing.T) {
+	deployment := func(name, id string) map[string]interface{} {
+		return map[string]interface{}{
+			"model_name":     name,
+			"litellm_params": map[string]interface{}{"model": "openai/gpt-4o"},
+			"model_info":     map[string]interface{}{"id": id},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		models  []map[string]interface{}
+		status  int
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name:   "no match",
+			models: []map[string]interface{}{deployment("gpt-4o-mini", "model-1")},
+			wantID: "gpt-4o",
+		},
+		{
+			name: "one match",
+			models: []map[string]interface{}{
+				deployment("gpt-4o-mini", "model-1"),
+				deployment("gpt-4o", "model-2"),
+			},
+			wantID: "model-2",
+		},
+		{
+			name: "several matches",
+			models: []map[string]interface{}{
+				deployment("gpt-4o", "model-1"),
+				deployment("gpt-4o", "model-2"),
+			},
+			wantErr: true,
+		},
+		{
+			name:    "proxy error",
+			status:  http.StatusInternalServerError,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/model/info" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				if tc.status != 0 {
+					http.Error(w, `{"error": "database unavailable"}`, tc.status)
+					return
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"data": tc.models})
+			}))
+			defer server.Close()
+
+			rawState := map[string]interface{}{
+				"id":             "gpt-4o",
+				"name":           "gpt-4o",
+				"model_provider": "openai",
+				"model_name":     "gpt-4o",
+			}
+			got, err := resourceModelStateUpgradeV0(context.Background(), rawState, client.NewClient("sk-admin", server.URL))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got state %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got["id"] != tc.wantID {
+				t.Errorf("id = %v, want %s", got["id"], tc.wantID)
+			}
+			if tc.wantID != "gpt-4o" && got["model_id"] != tc.wantID {
+				t.Errorf("model_id = %v, want %s", got["model_id"], tc.wantID)
+			}
+		})
+	}
+}
+
+func TestResourceModel_cloudSecretsFingerprint(t *testing.T) {
+	config := map[string]interface{}{
+		"name":           "claude",
+		"model_provider": "bedrock",
+		"model_name":     "anthropic.claude-3-sonnet-20240229-v1:0",
+		"aws": []interface{}{map[string]interface{}{
+			"aws_region_name":           "us-east-1",
+			"aws_access_key_id":         "AKIA1234WXYZ",
+			"aws_secret_access_key_env": "AWS_SECRET_ACCESS_KEY",
+		}},
+	}
+	secrets := modelCloudSecrets(schema.TestResourceDataRaw(t, ResourceModel().Schema, config).Get)
+	want := map[string]string{
+		"aws_access_key_id":     "AKIA1234WXYZ",
+		"aws_secret_access_key": "os.environ/AWS_SECRET_ACCESS_KEY",
+	}
+	if !reflect.DeepEqual(secrets, want) {
+		t.Fatalf("modelCloudSecrets() = %v, want %v", secrets, want)
+	}
+
+	fingerprint, err := newSecretFingerprint(secretMapValue(secrets))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name        string
+		fingerprint string
+		wantUpdate  bool
+	}{
+		{"matching", fingerprint, false},
+		{"cleared by drift", "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := ResourceModel()
+			d := schema.TestResourceDataRaw(t, r.Schema, config)
+			d.SetId("model-1")
+			d.Set("model_id", "model-1")
+			d.Set("cloud_secrets_fingerprint", tc.fingerprint)
+			d.Set("api_key_fingerprint", "")
+
+			diff, err := r.SimpleDiff(context.Background(), d.State(), terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("diff: %v", err)
+			}
+			planned := false
+			if diff != nil {
+				_, planned = diff.GetAttribute("cloud_secrets_fingerprint")
+			}
+			if planned != tc.wantUpdate {
+				t.Errorf("fingerprint update planned = %t, want %t", planned, tc.wantUpdate)
+			}
+		})
+	}
+}
+
+func TestResourceModelRead_secretsNotReturned(t *testing.T) {
+	config := map[string]interface{}{
+		"name":           "claude",
+		"model_provider": "bedrock",
+		"model_name":     "anthropic.claude-3-sonnet-20240229-v1:0",
+		"api_key":        "sk-proj-1234abcd",
+		"aws": []interface{}{map[string]interface{}{
+			"aws_region_name":       "us-east-1",
+			"aws_access_key_id":     "AKIA1234WXYZ",
+			"aws_secret_access_key": "secret-1234",
+		}},
+	}
+
+	cases := []struct {
+		name         string
+		apiKey       string
+		wantAPIKeyFP bool
+	}{
+		{"not returned", "", true},
+		{"masked", "sk-p********abcd", true},
+		{"changed on the proxy", "sk-p********wxyz", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := map[string]interface{}{
+				"model":           "bedrock/anthropic.claude-3-sonnet-20240229-v1:0",
+				"aws_region_name": "us-east-1",
+			}
+			if tc.apiKey != "" {
+				params["api_key"] = tc.apiKey
+			}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/model/info" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL)
+				}
+				json.NewEncoder(w).Encode(map[string]interface{}{"data": []interface{}{
+					map[string]interface{}{
+						"model_name":     "claude",
+						"litellm_params": params,
+						"model_info":     map[string]interface{}{"id": "model-1"},
+					},
+				}})
+			}))
+			defer server.Close()
+
+			d := schema.TestResourceDataRaw(t, ResourceModel().Schema, config)
+			d.SetId("model-1")
+			if diags := setModelFingerprints(d, &client.Model{APIKey: "sk-proj-1234abcd"}); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			if diags := resourceModelRead(context.Background(), d, client.NewClient("sk-admin", server.URL)); diags.HasError() {
+				t.Fatalf("read: %v", diags)
+			}
+
+			if got := d.Get("api_key_fingerprint").(string) != ""; got != tc.wantAPIKeyFP {
+				t.Errorf("api_key_fingerprint kept = %t, want %t", got, tc.wantAPIKeyFP)
+			}
+			if d.Get("cloud_secrets_fingerprint").(string) == "" {
+				t.Errorf("cloud_secrets_fingerprint was cleared though the proxy does not return the secrets")
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
-package resources
+package resources_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -9,6 +11,8 @@ import (
 )
 
 func TestAccResourceModel_basic(t *testing.T) {
+	var modelID string
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { provider.TestAccPreCheck(t) },
 		ProviderFactories: provider.TestAccProviderFactories,
@@ -22,9 +26,14 @@ func TestAccResourceModel_basic(t *testing.T) {
 						"litellm_model.test", "model_provider", "openai"),
 					resource.TestCheckResourceAttr(
 						"litellm_model.test", "model_name", "gpt-4"),
+					resource.TestCheckResourceAttrWith(
+						"litellm_model.test", "model_id", func(value string) error {
+							modelID = value
+							return nil
+						}),
 				),
 			},
-			// Test update
+			// Renaming the model updates the deployment in place
 			{
 				Config: testAccResourceModelConfig_update(),
 				Check: resource.ComposeTestCheckFunc(
@@ -36,6 +45,13 @@ func TestAccResourceModel_basic(t *testing.T) {
 						"litellm_model.test", "model_name", "gpt-3.5-turbo"),
 					resource.TestCheckResourceAttr(
 						"litellm_model.test", "metadata.description", "Updated test model"),
+					resource.TestCheckResourceAttrWith(
+						"litellm_model.test", "model_id", func(value string) error {
+							if value != modelID {
+								return fmt.Errorf("expected deployment %s to be updated in place, got %s", modelID, value)
+							}
+							return nil
+						}),
 				),
 			},
 			// Import test
@@ -44,6 +60,13 @@ func TestAccResourceModel_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by model name
+			{
+				ResourceName:      "litellm_model.test",
+				ImportState:       true,
+				ImportStateId:     "test-model-updated",
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -64,6 +87,28 @@ func TestAccResourceModel_fullConfig(t *testing.T) {
 						"litellm_model.full", "model_name", "gpt-4"),
 					resource.TestCheckResourceAttr(
 						"litellm_model.full", "timeout", "30"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "stream_timeout", "10"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "max_retries", "2"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "rpm", "600"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "tpm", "100000"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "organization", "org-test"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "input_cost_per_token", "0.00003"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "output_cost_per_token", "0.00006"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "max_budget", "500"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "budget_duration", "30d"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "use_in_pass_through", "true"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.full", "max_file_size_mb", "25"),
 					resource.TestCheckResourceAttr(
 						"litellm_model.full", "metadata.description", "Full test model"),
 					resource.TestCheckResourceAttr(
@@ -114,6 +159,19 @@ resource "litellm_model" "full" {
   model_name     = "gpt-4"
   api_base       = "https://api.openai.com/v1"
   timeout        = 30
+  stream_timeout = 10
+  max_retries    = 2
+  rpm            = 600
+  tpm            = 100000
+  organization   = "org-test"
+
+  input_cost_per_token  = 0.00003
+  output_cost_per_token = 0.00006
+  max_budget            = 500
+  budget_duration       = "30d"
+  use_in_pass_through   = true
+  max_file_size_mb      = 25
+
   metadata = {
     description  = "Full test model"
     environment  = "testing"
@@ -122,3 +180,505 @@ resource "litellm_model" "full" {
 }
 `)
 }
+
+func TestAccResourceModel_invalidParams(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceModelConfig_param("max_retries", "-1"),
+				ExpectError: regexp.MustCompile(`max_retries cannot be less than 0`),
+			},
+			{
+				Config:      testAccResourceModelConfig_param("budget_duration", `"monthly"`),
+				ExpectError: regexp.MustCompile(`budget_duration must be a duration`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_param(name, value string) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "invalid" {
+  name           = "invalid-params-model"
+  model_provider = "openai"
+  model_name     = "gpt-4"
+  %s = %s
+}
+`, name, value)
+}
+
+func TestAccResourceModel_cloudBlocks(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_cloudBlocks(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.bedrock", "aws.0.aws_region_name", "us-east-1"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.bedrock", "aws.0.aws_access_key_id", "AKIAEXAMPLE"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.vertex", "vertex.0.vertex_project", "test-project"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.vertex", "vertex.0.vertex_location", "us-central1"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.azure", "azure.0.api_version", "2024-02-01"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.azure", "api_version", ""),
+					resource.TestCheckResourceAttr(
+						"litellm_model.watsonx", "watsonx.0.watsonx_region_name", "us-south"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceModel_cloudBlocksInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_cloudBlock("openai", `
+  aws {
+    aws_region_name = "us-east-1"
+  }`),
+				ExpectError: regexp.MustCompile(`the aws block can only be used with model_provider bedrock or sagemaker`),
+			},
+			{
+				Config: testAccResourceModelConfig_cloudBlock("bedrock", `
+  aws {
+    aws_region_name   = "us-east-1"
+    aws_access_key_id = "AKIAEXAMPLE"
+  }`),
+				ExpectError: regexp.MustCompile(`aws_access_key_id and aws_secret_access_key must be set together`),
+			},
+			{
+				Config: testAccResourceModelConfig_cloudBlock("vertex_ai", `
+  vertex {
+    vertex_project     = "test-project"
+    vertex_location    = "us-central1"
+    vertex_credentials = "not json"
+  }`),
+				ExpectError: regexp.MustCompile(`vertex_credentials must be valid JSON`),
+			},
+			{
+				Config: testAccResourceModelConfig_cloudBlock("azure", `
+  api_version = "2024-02-01"
+
+  azure {
+    api_version = "2024-02-01"
+  }`),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_cloudBlocks() string {
+	return `
+resource "litellm_model" "bedrock" {
+  name           = "test-bedrock-model"
+  model_provider = "bedrock"
+  model_name     = "anthropic.claude-3-sonnet-20240229-v1:0"
+
+  aws {
+    aws_access_key_id     = "AKIAEXAMPLE"
+    aws_secret_access_key = "secret"
+    aws_region_name       = "us-east-1"
+  }
+}
+
+resource "litellm_model" "vertex" {
+  name           = "test-vertex-model"
+  model_provider = "vertex_ai"
+  model_name     = "gemini-1.5-pro"
+
+  vertex {
+    vertex_project     = "test-project"
+    vertex_location    = "us-central1"
+    vertex_credentials = jsonencode({ type = "service_account" })
+  }
+}
+
+resource "litellm_model" "azure" {
+  name           = "test-azure-model"
+  model_provider = "azure"
+  model_name     = "my-gpt4-deployment"
+  api_base       = "https://example.openai.azure.com"
+  api_key        = "azure-key"
+
+  azure {
+    api_version = "2024-02-01"
+  }
+}
+
+resource "litellm_model" "watsonx" {
+  name           = "test-watsonx-model"
+  model_provider = "watsonx"
+  model_name     = "ibm/granite-13b-chat-v2"
+
+  watsonx {
+    watsonx_region_name = "us-south"
+  }
+}
+`
+}
+
+func testAccResourceModelConfig_cloudBlock(modelProvider, block string) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "invalid" {
+  name           = "invalid-cloud-model"
+  model_provider = %q
+  model_name     = "test"
+%s
+}
+`, modelProvider, block)
+}
+
+func TestAccResourceModel_providerCatalog(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceModelConfig_provider("bedrok"),
+				ExpectError: regexp.MustCompile(`did you mean "bedrock"\?`),
+			},
+			// Aliases are sent as the canonical name without a diff
+			{
+				Config: testAccResourceModelConfig_provider("together"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.catalog", "model_provider", "together_ai"),
+				),
+			},
+			{
+				Config:   testAccResourceModelConfig_provider("together"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_provider(modelProvider string) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "catalog" {
+  name           = "catalog-test-model"
+  model_provider = %q
+  model_name     = "meta-llama/Llama-3-70b-chat-hf"
+}
+`, modelProvider)
+}
+
+func TestAccResourceModel_multipleDeployments(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_multipleDeployments(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.eastus", "name", "gpt-4o"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.westeu", "name", "gpt-4o"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.eastus", "id", "gpt-4o-eastus"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.eastus", "order", "1"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.westeu", "order", "2"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.westeu", "weight", "2"),
+					resource.TestCheckResourceAttrSet(
+						"litellm_model.westeu", "model_id"),
+					resource.TestCheckResourceAttr(
+						"data.litellm_model.eastus", "rpm", "100"),
+				),
+			},
+			// Routing knobs update in place
+			{
+				Config: testAccResourceModelConfig_multipleDeployments(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.westeu", "weight", "3"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.eastus", "id", "gpt-4o-eastus"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_multipleDeployments(weight int) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "eastus" {
+  name           = "gpt-4o"
+  model_id       = "gpt-4o-eastus"
+  model_provider = "azure"
+  model_name     = "gpt-4o-eastus"
+  api_base       = "https://eastus.example.openai.azure.com"
+  order          = 1
+  rpm            = 100
+
+  azure {
+    api_version = "2024-02-01"
+  }
+}
+
+resource "litellm_model" "westeu" {
+  name           = "gpt-4o"
+  model_provider = "azure"
+  model_name     = "gpt-4o-westeu"
+  api_base       = "https://westeu.example.openai.azure.com"
+  order          = 2
+  weight         = %d
+
+  azure {
+    api_version = "2024-02-01"
+  }
+}
+
+data "litellm_model" "eastus" {
+  name     = litellm_model.eastus.name
+  model_id = litellm_model.eastus.model_id
+}
+`, weight)
+}
+
+func TestAccResourceModel_modelInfo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_modelInfo("chat"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.mode", "chat"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.base_model", "azure/gpt-4o"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.tier", "paid"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.access_groups.0", "beta-models"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.team_id", "test-team"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.team_public_model_name", "team-gpt"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "model_info.0.supports_vision", "true"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.info", "db_model", "true"),
+					resource.TestCheckResourceAttrSet(
+						"litellm_model.info", "created_at"),
+				),
+			},
+			{
+				Config:      testAccResourceModelConfig_modelInfo("chatbot"),
+				ExpectError: regexp.MustCompile(`expected model_info.0.mode to be one of`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_modelInfo(mode string) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "info" {
+  name           = "info-test-model"
+  model_provider = "azure"
+  model_name     = "my-gpt4o-deployment"
+
+  azure {
+    api_version = "2024-02-01"
+  }
+
+  model_info {
+    mode                   = %q
+    base_model             = "azure/gpt-4o"
+    tier                   = "paid"
+    access_groups          = ["beta-models"]
+    team_id                = "test-team"
+    team_public_model_name = "team-gpt"
+    supports_vision        = true
+  }
+}
+`, mode)
+}
+
+func TestAccResourceModel_envSecrets(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_envSecrets(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.env", "api_key_env", "OPENAI_API_KEY"),
+					resource.TestCheckNoResourceAttr(
+						"litellm_model.env", "api_key"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.env_bedrock", "aws.0.aws_access_key_id_env", "AWS_ACCESS_KEY_ID"),
+					resource.TestCheckResourceAttr(
+						"litellm_model.env_bedrock", "aws.0.aws_secret_access_key_env", "AWS_SECRET_ACCESS_KEY"),
+				),
+			},
+			// References round-trip without a diff
+			{
+				Config:   testAccResourceModelConfig_envSecrets(),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccResourceModelConfig_param("api_key_env", `"OPENAI-API-KEY"`),
+				ExpectError: regexp.MustCompile(`api_key_env must be an environment variable name`),
+			},
+			{
+				Config: testAccResourceModelConfig_param("api_key_env", `"OPENAI_API_KEY"
+  api_key = "sk-literal"`),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_envSecrets() string {
+	return `
+resource "litellm_model" "env" {
+  name           = "env-test-model"
+  model_provider = "openai"
+  model_name     = "gpt-4o"
+  api_key_env    = "OPENAI_API_KEY"
+}
+
+resource "litellm_model" "env_bedrock" {
+  name           = "env-test-bedrock-model"
+  model_provider = "bedrock"
+  model_name     = "anthropic.claude-3-sonnet-20240229-v1:0"
+
+  aws {
+    aws_access_key_id_env     = "AWS_ACCESS_KEY_ID"
+    aws_secret_access_key_env = "AWS_SECRET_ACCESS_KEY"
+    aws_region_name           = "us-east-1"
+  }
+}
+`
+}
+
+func TestAccResourceModel_apiKeyFingerprint(t *testing.T) {
+	var fingerprint string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_apiKey("sk-test-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(
+						"litellm_model.fingerprint", "api_key_fingerprint", func(value string) error {
+							if value == "" || strings.Contains(value, "sk-test-1") {
+								return fmt.Errorf("expected a fingerprint of the api key, got %q", value)
+							}
+							fingerprint = value
+							return nil
+						}),
+				),
+			},
+			// An unchanged key keeps its fingerprint and plans no changes
+			{
+				Config:   testAccResourceModelConfig_apiKey("sk-test-1"),
+				PlanOnly: true,
+			},
+			// Changing only the key is enough to send it again
+			{
+				Config: testAccResourceModelConfig_apiKey("sk-test-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith(
+						"litellm_model.fingerprint", "api_key_fingerprint", func(value string) error {
+							if value == "" || value == fingerprint {
+								return fmt.Errorf("expected the fingerprint to change, got %q", value)
+							}
+							return nil
+						}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_apiKey(apiKey string) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "fingerprint" {
+  name           = "fingerprint-test-model"
+  model_provider = "openai"
+  model_name     = "gpt-4o"
+  api_key        = %q
+}
+`, apiKey)
+}
+
+func TestAccResourceModel_metadataJSON(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceModelConfig_metadataJSON(`jsonencode({
+    owners      = ["alice", "bob"]
+    cost_center = 4200
+    billing     = { tier = "gold" }
+  })`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_model.metadata", "metadata_json",
+						`{"billing":{"tier":"gold"},"cost_center":4200,"owners":["alice","bob"]}`),
+					resource.TestCheckResourceAttr(
+						"data.litellm_model.metadata", "metadata_json",
+						`{"billing":{"tier":"gold"},"cost_center":4200,"owners":["alice","bob"]}`),
+				),
+			},
+			// Reordered keys and whitespace are not a change
+			{
+				Config: testAccResourceModelConfig_metadataJSON(`<<-EOT
+    {
+      "owners": ["alice", "bob"],
+      "billing": {"tier": "gold"},
+      "cost_center": 4200
+    }
+  EOT`),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccResourceModelConfig_metadataJSON(`jsonencode(["alice", "bob"])`),
+				ExpectError: regexp.MustCompile(`metadata_json must be a JSON object`),
+			},
+			{
+				Config: testAccResourceModelConfig_param("metadata_json", `"{}"
+  metadata = { team = "search" }`),
+				ExpectError: regexp.MustCompile(`conflicts with`),
+			},
+		},
+	})
+}
+
+func testAccResourceModelConfig_metadataJSON(metadata string) string {
+	return fmt.Sprintf(`
+resource "litellm_model" "metadata" {
+  name           = "metadata-test-model"
+  model_provider = "openai"
+  model_name     = "gpt-4o"
+  metadata_json  = %s
+}
+
+data "litellm_model" "metadata" {
+  name     = litellm_model.metadata.name
+  model_id = litellm_model.metadata.model_id
+}
+`, metadata)
+}
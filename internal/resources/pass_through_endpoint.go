@@ -0,0 +1,211 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourcePassThroughEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePassThroughEndpointCreate,
+		ReadContext:   resourcePassThroughEndpointRead,
+		DeleteContext: resourcePassThroughEndpointDelete,
+		CustomizeDiff: resourcePassThroughEndpointCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The route added to the proxy, e.g. `/cohere`. Also used as the endpoint ID",
+				ValidateFunc: validation.URLPath,
+			},
+			"target": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The absolute URL requests on `path` are forwarded to",
+				ValidateFunc: validation.AbsoluteURL,
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Headers forwarded to the target, e.g. vendor API keys",
+			},
+			"headers_fingerprint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Salted SHA-256 fingerprint of the headers last sent. Cleared when the headers on the proxy no longer match, so the next apply recreates the endpoint with them",
+			},
+		},
+	}
+}
+
+func resourcePassThroughEndpointCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	endpoint := &client.PassThroughEndpoint{
+		Path:    d.Get("path").(string),
+		Target:  d.Get("target").(string),
+		Headers: make(map[string]string),
+	}
+
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		endpoint.Headers[k] = v.(string)
+	}
+
+	if err := c.CreatePassThroughEndpoint(endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(endpoint.Path)
+
+	fingerprint, err := newSecretFingerprint(secretMapValue(endpoint.Headers))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("headers_fingerprint", fingerprint)
+
+	return resourcePassThroughEndpointRead(ctx, d, m)
+}
+
+func resourcePassThroughEndpointRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	endpoint, err := c.GetPassThroughEndpoint(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if endpoint == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", endpoint.Path)
+	d.Set("target", endpoint.Target)
+	if diags := flattenPassThroughHeaders(d, endpoint.Headers); diags.HasError() {
+		return diags
+	}
+
+	return nil
+}
+
+// flattenPassThroughHeaders keeps the headers in state that the values
+// returned by the proxy, which may be masked, could have been made from. If
+// any header no longer matches, the fingerprint is cleared so the next plan
+// recreates the endpoint with the configured headers. Endpoints created
+// before headers were fingerprinted get a fingerprint once they match.
+func flattenPassThroughHeaders(d *schema.ResourceData, returned map[string]string) diag.Diagnostics {
+	headers := make(map[string]string, len(returned))
+	for k, v := range returned {
+		headers[k] = v
+	}
+
+	matched := true
+	for k, v := range d.Get("headers").(map[string]interface{}) {
+		// The proxy returns every header, so a missing one was removed.
+		if r, ok := returned[k]; !ok || !maskedValueMatches(r, v.(string)) {
+			matched = false
+			continue
+		}
+		headers[k] = v.(string)
+	}
+	d.Set("headers", headers)
+
+	if !matched {
+		d.Set("headers_fingerprint", "")
+		return nil
+	}
+
+	fingerprint, err := updateSecretFingerprint(d.Get("headers_fingerprint").(string), secretMapValue(headers))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("headers_fingerprint", fingerprint)
+
+	return nil
+}
+
+func resourcePassThroughEndpointDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeletePassThroughEndpoint(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourcePassThroughEndpointCustomizeDiff plans a replacement when the
+// headers no longer match their fingerprint, and rejects paths that would
+// shadow a route the proxy already serves.
+func resourcePassThroughEndpointCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() != "" && d.NewValueKnown("headers") {
+		headers := expandStringMap(d.Get("headers").(map[string]interface{}))
+		if !secretFingerprintMatches(d.Get("headers_fingerprint").(string), secretMapValue(headers)) {
+			if err := d.SetNewComputed("headers_fingerprint"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.HasChange("path") || !d.NewValueKnown("path") {
+		return nil
+	}
+
+	c := m.(*client.Client)
+	path := d.Get("path").(string)
+
+	routes, err := c.ListRoutes()
+	if err != nil {
+		return fmt.Errorf("failed to list proxy routes: %w", err)
+	}
+
+	for _, route := range routes {
+		if routeMatchesPath(route.Path, path) {
+			return fmt.Errorf("path %q collides with existing proxy route %q", path, route.Path)
+		}
+	}
+
+	return nil
+}
+
+// routeMatchesPath reports whether a request to path would be served by the
+// route template, e.g. "/key/{key}/regenerate" or "/openai/{endpoint:path}".
+// A {x:path} parameter matches any remainder, including none, so the bare
+// prefix of such a route collides with it too.
+func routeMatchesPath(route, path string) bool {
+	routeParts := strings.Split(strings.Trim(route, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, part := range routeParts {
+		isParam := strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}")
+		if isParam && strings.HasSuffix(part, ":path}") {
+			return true
+		}
+		if i >= len(pathParts) {
+			return false
+		}
+		if !isParam && part != pathParts[i] {
+			return false
+		}
+	}
+
+	return len(routeParts) == len(pathParts)
+}
@@ -0,0 +1,133 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestRouteMatchesPath(t *testing.T) {
+	cases := []struct {
+		route, path string
+		want        bool
+	}{
+		{"/chat/completions", "/chat/completions", true},
+		{"/chat/completions", "/chat/completions/", true},
+		{"/chat/completions", "/chat", false},
+		{"/chat/completions", "/chat/completions/extra", false},
+		{"/key/{key}/regenerate", "/key/abc/regenerate", true},
+		{"/key/{key}/regenerate", "/key/abc", false},
+		{"/key/{key}/regenerate", "/key/abc/delete", false},
+		{"/openai/{endpoint:path}", "/openai/v1/chat/completions", true},
+		{"/openai/{endpoint:path}", "/openai/files", true},
+		{"/openai/{endpoint:path}", "/openai", true},
+		{"/openai/{endpoint:path}", "/openai-eu", false},
+		{"/openai/{endpoint:path}", "/vendor-rerank", false},
+		{"/{endpoint:path}", "/anything", true},
+	}
+	for _, tc := range cases {
+		if got := routeMatchesPath(tc.route, tc.path); got != tc.want {
+			t.Errorf("routeMatchesPath(%q, %q) = %t, want %t", tc.route, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestResourcePassThroughEndpoint_headersFingerprint(t *testing.T) {
+	fingerprint, err := newSecretFingerprint(secretMapValue(map[string]string{"Authorization": "Bearer sk-1234"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name        string
+		fingerprint string
+		wantNew     bool
+	}{
+		{"matching", fingerprint, false},
+		{"cleared by drift", "", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := ResourcePassThroughEndpoint()
+			state := &terraform.InstanceState{
+				ID: "/vendor-rerank",
+				Attributes: map[string]string{
+					"id":                    "/vendor-rerank",
+					"path":                  "/vendor-rerank",
+					"target":                "https://api.example.com/rerank",
+					"headers.%":             "1",
+					"headers.Authorization": "Bearer sk-1234",
+					"headers_fingerprint":   tc.fingerprint,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"path":    "/vendor-rerank",
+				"target":  "https://api.example.com/rerank",
+				"headers": map[string]interface{}{"Authorization": "Bearer sk-1234"},
+			})
+
+			diff, err := r.SimpleDiff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatalf("diff: %v", err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != tc.wantNew {
+				t.Errorf("replacement planned = %t, want %t", got, tc.wantNew)
+			}
+		})
+	}
+}
+
+func TestFlattenPassThroughHeaders(t *testing.T) {
+	sent := map[string]interface{}{"Authorization": "Bearer sk-1234abcd"}
+
+	cases := []struct {
+		name     string
+		returned map[string]string
+		want     map[string]interface{}
+		cleared  bool
+	}{
+		{
+			name:     "masked",
+			returned: map[string]string{"Authorization": "Bear********abcd"},
+			want:     sent,
+		},
+		{
+			name:     "changed on the proxy",
+			returned: map[string]string{"Authorization": "Bear********wxyz"},
+			want:     map[string]interface{}{"Authorization": "Bear********wxyz"},
+			cleared:  true,
+		},
+		{
+			name:     "removed on the proxy",
+			returned: map[string]string{},
+			want:     map[string]interface{}{},
+			cleared:  true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, ResourcePassThroughEndpoint().Schema, map[string]interface{}{
+				"headers": sent,
+			})
+			fingerprint, err := newSecretFingerprint(secretMapValue(expandStringMap(sent)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			d.Set("headers_fingerprint", fingerprint)
+
+			if diags := flattenPassThroughHeaders(d, tc.returned); diags.HasError() {
+				t.Fatalf("flatten: %v", diags)
+			}
+
+			if got := d.Get("headers"); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("headers = %v, want %v", got, tc.want)
+			}
+			if got := d.Get("headers_fingerprint").(string); (got == "") != tc.cleared {
+				t.Errorf("headers_fingerprint = %q, cleared should be %t", got, tc.cleared)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package resources_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourcePassThroughEndpoint_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePassThroughEndpointConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_pass_through_endpoint.test", "path", "/vendor-rerank"),
+					resource.TestCheckResourceAttr(
+						"litellm_pass_through_endpoint.test", "target", "https://api.vendor.example/v1/rerank"),
+					resource.TestCheckResourceAttr(
+						"litellm_pass_through_endpoint.test", "headers.Authorization", "bearer test"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_pass_through_endpoint.test",
+				ImportState:       true,
+				ImportStateId:     "/vendor-rerank",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassThroughEndpoint_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourcePassThroughEndpointConfig_withPath("vendor-rerank", "https://api.vendor.example"),
+				ExpectError: regexp.MustCompile(`must start with a leading slash`),
+			},
+			{
+				Config:      testAccResourcePassThroughEndpointConfig_withPath("/vendor-rerank", "api.vendor.example"),
+				ExpectError: regexp.MustCompile(`must be an absolute http or https URL`),
+			},
+			{
+				Config:      testAccResourcePassThroughEndpointConfig_withPath("/key/generate", "https://api.vendor.example"),
+				ExpectError: regexp.MustCompile(`collides with existing proxy route`),
+			},
+		},
+	})
+}
+
+func testAccResourcePassThroughEndpointConfig_basic() string {
+	return `
+resource "litellm_pass_through_endpoint" "test" {
+  path   = "/vendor-rerank"
+  target = "https://api.vendor.example/v1/rerank"
+  headers = {
+    Authorization = "bearer test"
+  }
+}
+`
+}
+
+func testAccResourcePassThroughEndpointConfig_withPath(path, target string) string {
+	return fmt.Sprintf(`
+resource "litellm_pass_through_endpoint" "invalid" {
+  path   = %q
+  target = %q
+}
+`, path, target)
+}
@@ -0,0 +1,223 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+// proxySettings is a proxy-wide settings object, such as the defaults for
+// new users or teams. There is exactly one instance per proxy, so its
+// resource adopts the current settings on create and records them in
+// previous_settings for restore_on_delete.
+type proxySettings struct {
+	// name is both the resource ID and the object's name in the
+	// /get/<name> and /update/<name> endpoints.
+	name        string
+	description string
+	attributes  func() map[string]*schema.Schema
+}
+
+// settingsSchema returns the settings attributes, as Optional for the
+// resource or Computed only for previous_settings.
+func (p proxySettings) settingsSchema(computedOnly bool) map[string]*schema.Schema {
+	s := p.attributes()
+
+	for _, v := range s {
+		v.Computed = true
+		if computedOnly {
+			v.ValidateFunc = nil
+		} else {
+			v.Optional = true
+		}
+	}
+
+	return s
+}
+
+func (p proxySettings) resource() *schema.Resource {
+	s := p.settingsSchema(false)
+	s["restore_on_delete"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Restore the settings recorded before Terraform took over when the resource is destroyed. If false, the settings are left in place",
+	}
+	s["previous_settings"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The settings in place before Terraform managed them",
+		Elem:        &schema.Resource{Schema: p.settingsSchema(true)},
+	}
+
+	return &schema.Resource{
+		CreateContext: p.create,
+		ReadContext:   p.read,
+		UpdateContext: p.update,
+		DeleteContext: p.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: p.importState,
+		},
+
+		Schema: s,
+	}
+}
+
+func (p proxySettings) create(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := p.recordPrevious(c, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := make(map[string]interface{})
+	for k := range p.attributes() {
+		if v, ok := d.GetOk(k); ok {
+			values[k] = v
+		}
+	}
+
+	if err := c.UpdateSettings(p.name, values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(p.name)
+
+	return p.read(ctx, d, m)
+}
+
+func (p proxySettings) read(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	values, err := c.GetSettings(p.name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for k, v := range p.flatten(values) {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+func (p proxySettings) update(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	values := make(map[string]interface{})
+	for k := range p.attributes() {
+		if d.HasChange(k) {
+			values[k] = settingValue(d.Get(k))
+		}
+	}
+
+	if err := c.UpdateSettings(p.name, values); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return p.read(ctx, d, m)
+}
+
+func (p proxySettings) delete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	previous := d.Get("previous_settings").([]interface{})
+	if d.Get("restore_on_delete").(bool) && len(previous) == 1 && previous[0] != nil {
+		if err := c.UpdateSettings(p.name, p.restoreValues(previous[0].(map[string]interface{}))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// importState adopts the current settings, which are also recorded as
+// previous_settings.
+func (p proxySettings) importState(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.Client)
+
+	if d.Id() != p.name {
+		return nil, fmt.Errorf("unexpected import ID %q, the %s are imported as %q", d.Id(), p.description, p.name)
+	}
+
+	if err := p.recordPrevious(c, d); err != nil {
+		return nil, err
+	}
+	d.Set("restore_on_delete", true)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func (p proxySettings) recordPrevious(c *client.Client, d *schema.ResourceData) error {
+	previous, err := c.GetSettings(p.name)
+	if err != nil {
+		return err
+	}
+
+	return d.Set("previous_settings", []interface{}{p.flatten(previous)})
+}
+
+// restoreValues returns every setting as recorded in previous. Settings that
+// were unset are sent as null, so values Terraform set are removed again.
+func (p proxySettings) restoreValues(previous map[string]interface{}) map[string]interface{} {
+	values := make(map[string]interface{})
+	for k := range p.attributes() {
+		values[k] = settingValue(previous[k])
+	}
+	return values
+}
+
+// flatten converts the values returned by the proxy to the types of the
+// settings attributes. Unset settings become zero values.
+func (p proxySettings) flatten(values map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, s := range p.attributes() {
+		v := values[k]
+		switch s.Type {
+		case schema.TypeString:
+			str, _ := v.(string)
+			result[k] = str
+		case schema.TypeFloat:
+			f, _ := v.(float64)
+			result[k] = f
+		case schema.TypeInt:
+			f, _ := v.(float64)
+			result[k] = int(f)
+		case schema.TypeList:
+			l, _ := v.([]interface{})
+			result[k] = l
+		}
+	}
+	return result
+}
+
+// settingValue maps a zero value to nil, since the settings endpoints
+// cannot tell an unset setting from a zero one.
+func settingValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+	case float64:
+		if v == 0 {
+			return nil
+		}
+	case int:
+		if v == 0 {
+			return nil
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+	}
+	return v
+}
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+)
+
+// settingsProxy stores one settings object the way the proxy does: PATCH
+// merges the sent values into it, and null unsets a value.
+func settingsProxy(t *testing.T, name string, values map[string]interface{}) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/get/"+name:
+			json.NewEncoder(w).Encode(map[string]interface{}{"values": values})
+		case r.Method == http.MethodPatch && r.URL.Path == "/update/"+name:
+			var update map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+				t.Errorf("failed to decode request: %v", err)
+			}
+			for k, v := range update {
+				if v == nil {
+					delete(values, k)
+				} else {
+					values[k] = v
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"values": values})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestProxySettings_restoreOnDelete(t *testing.T) {
+	cases := []struct {
+		name     string
+		settings proxySettings
+		before   map[string]interface{}
+		config   map[string]interface{}
+	}{
+		{
+			name:     "unset before",
+			settings: defaultTeamSettings,
+			before:   map[string]interface{}{},
+			config: map[string]interface{}{
+				"models":          []interface{}{"gpt-4o"},
+				"max_budget":      25.0,
+				"budget_duration": "30d",
+				"tpm_limit":       1000,
+				"rpm_limit":       10,
+			},
+		},
+		{
+			name:     "partly set before",
+			settings: internalUserSettings,
+			before: map[string]interface{}{
+				"user_role":  "internal_user_viewer",
+				"max_budget": 5.0,
+			},
+			config: map[string]interface{}{
+				"user_role":       "internal_user",
+				"budget_duration": "7d",
+				"models":          []interface{}{"gpt-4o"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := make(map[string]interface{})
+			for k, v := range tc.before {
+				values[k] = v
+			}
+			server := settingsProxy(t, tc.settings.name, values)
+			c := client.NewClient("sk-admin", server.URL)
+
+			r := tc.settings.resource()
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+			if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+				t.Fatalf("create: %v", diags)
+			}
+			for k := range tc.config {
+				if _, ok := values[k]; !ok {
+					t.Errorf("create did not set %s on the proxy", k)
+				}
+			}
+
+			if diags := r.DeleteContext(context.Background(), d, c); diags.HasError() {
+				t.Fatalf("delete: %v", diags)
+			}
+			if !reflect.DeepEqual(values, tc.before) {
+				t.Errorf("settings after delete = %v, want %v", values, tc.before)
+			}
+		})
+	}
+}
+
+func TestProxySettings_keepOnDelete(t *testing.T) {
+	values := make(map[string]interface{})
+	server := settingsProxy(t, defaultTeamSettings.name, values)
+	c := client.NewClient("sk-admin", server.URL)
+
+	r := defaultTeamSettings.resource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"max_budget":        25.0,
+		"restore_on_delete": false,
+	})
+	if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	if diags := r.DeleteContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("delete: %v", diags)
+	}
+
+	if values["max_budget"] != 25.0 {
+		t.Errorf("max_budget after delete = %v, want 25", values["max_budget"])
+	}
+}
@@ -0,0 +1,148 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTagCreate,
+		ReadContext:   resourceTagRead,
+		UpdateContext: resourceTagUpdate,
+		DeleteContext: resourceTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the tag",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of what the tag represents, e.g. a cost center",
+			},
+			"models": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "List of models requests with this tag are routed to",
+			},
+			"model_info": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional model information for the tag",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp of the tag",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update timestamp of the tag",
+			},
+		},
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	tag := expandTag(d)
+	tag.Name = d.Get("name").(string)
+
+	if err := c.CreateTag(tag); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(tag.Name)
+
+	return resourceTagRead(ctx, d, m)
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	tag, err := c.GetTag(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if tag == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", tag.Name)
+	d.Set("description", tag.Description)
+	d.Set("models", tag.Models)
+	d.Set("model_info", tag.ModelInfo)
+	d.Set("created_at", tag.CreatedAt)
+	d.Set("updated_at", tag.UpdatedAt)
+
+	return nil
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	tag := expandTag(d)
+	tag.Name = d.Id()
+
+	if err := c.UpdateTag(tag); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTagRead(ctx, d, m)
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteTag(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandTag(d *schema.ResourceData) *client.Tag {
+	tag := &client.Tag{
+		Description: d.Get("description").(string),
+	}
+
+	if v, ok := d.GetOk("models"); ok {
+		models := make([]string, 0)
+		for _, model := range v.([]interface{}) {
+			models = append(models, model.(string))
+		}
+		tag.Models = models
+	}
+
+	if v, ok := d.GetOk("model_info"); ok {
+		modelInfo := make(map[string]string)
+		for k, v := range v.(map[string]interface{}) {
+			modelInfo[k] = v.(string)
+		}
+		tag.ModelInfo = modelInfo
+	}
+
+	return tag
+}
@@ -0,0 +1,84 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceTag_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_tag.test", "name", "cost-center-1234"),
+					resource.TestCheckResourceAttr(
+						"litellm_tag.test", "description", "FinOps cost center 1234"),
+					resource.TestCheckResourceAttr(
+						"litellm_tag.test", "models.0", "gpt-4"),
+					resource.TestCheckResourceAttr(
+						"litellm_key.test", "tags.0", "cost-center-1234"),
+					resource.TestCheckResourceAttr(
+						"data.litellm_tag.test", "models.#", "1"),
+				),
+			},
+			// Test update
+			{
+				Config: testAccResourceTagConfig_update(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_tag.test", "description", "FinOps cost center 1234 (updated)"),
+					resource.TestCheckResourceAttr(
+						"litellm_tag.test", "models.#", "2"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_tag.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTagConfig_basic() string {
+	return `
+resource "litellm_tag" "test" {
+  name        = "cost-center-1234"
+  description = "FinOps cost center 1234"
+  models      = ["gpt-4"]
+}
+
+resource "litellm_key" "test" {
+  key_alias = "tagged-key"
+  team_id   = "test-team"
+  tags      = [litellm_tag.test.name]
+}
+
+data "litellm_tag" "test" {
+  name = litellm_tag.test.name
+}
+`
+}
+
+func testAccResourceTagConfig_update() string {
+	return `
+resource "litellm_tag" "test" {
+  name        = "cost-center-1234"
+  description = "FinOps cost center 1234 (updated)"
+  models      = ["gpt-4", "gpt-3.5-turbo"]
+}
+
+resource "litellm_key" "test" {
+  key_alias = "tagged-key"
+  team_id   = "test-team"
+  tags      = [litellm_tag.test.name]
+}
+`
+}
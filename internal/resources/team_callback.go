@@ -0,0 +1,272 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceTeamCallback() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamCallbackCreate,
+		ReadContext:   resourceTeamCallbackRead,
+		UpdateContext: resourceTeamCallbackUpdate,
+		DeleteContext: resourceTeamCallbackDelete,
+		CustomizeDiff: resourceTeamCallbackCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamCallbackImport,
+		},
+
+		Description: "Manages a logging callback for a team, or switches logging off for the team " +
+			"when `logging_disabled` is set. The proxy has no endpoint to remove a single callback, " +
+			"so destroying a callback disables logging for the team and re-adds its other callbacks.",
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The team the callback applies to",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"logging_disabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Disable all logging for the team instead of adding a callback",
+			},
+			"callback_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The logging integration to send to (e.g. 'langfuse', 'langsmith', 'gcs')",
+			},
+			"callback_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "success_and_failure",
+				Description:  "Which calls are logged: 'success', 'failure' or 'success_and_failure'",
+				ValidateFunc: validation.OneOf("success", "failure", "success_and_failure"),
+			},
+			"callback_vars": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Credentials and settings for the callback (e.g. `langfuse_public_key`, `langfuse_secret_key`)",
+			},
+		},
+	}
+}
+
+func resourceTeamCallbackCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	teamID := d.Get("team_id").(string)
+
+	if d.Get("logging_disabled").(bool) {
+		if err := c.DisableTeamLogging(teamID); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(teamID)
+		return resourceTeamCallbackRead(ctx, d, m)
+	}
+
+	callback := &client.TeamCallback{
+		CallbackName: d.Get("callback_name").(string),
+		CallbackType: d.Get("callback_type").(string),
+		CallbackVars: expandStringMap(d.Get("callback_vars").(map[string]interface{})),
+	}
+
+	if err := c.AddTeamCallback(teamID, callback); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", teamID, callback.CallbackName))
+
+	return resourceTeamCallbackRead(ctx, d, m)
+}
+
+func resourceTeamCallbackRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	settings, err := c.GetTeamCallbacks(d.Get("team_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if settings == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if d.Get("logging_disabled").(bool) {
+		d.Set("logging_disabled", len(settings.SuccessCallbacks) == 0 && len(settings.FailureCallbacks) == 0)
+		return nil
+	}
+
+	callbackType := teamCallbackType(settings, d.Get("callback_name").(string))
+	if callbackType == "" {
+		d.SetId("")
+		return nil
+	}
+	d.Set("callback_type", callbackType)
+
+	// callback_vars is shared by all callbacks on the team, so only read back
+	// the variables this resource manages.
+	vars := make(map[string]string)
+	for k := range d.Get("callback_vars").(map[string]interface{}) {
+		if v, ok := settings.CallbackVars[k]; ok {
+			vars[k] = v
+		}
+	}
+	d.Set("callback_vars", vars)
+
+	return nil
+}
+
+func resourceTeamCallbackUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if d.HasChange("callback_vars") {
+		callback := &client.TeamCallback{
+			CallbackName: d.Get("callback_name").(string),
+			CallbackType: d.Get("callback_type").(string),
+			CallbackVars: expandStringMap(d.Get("callback_vars").(map[string]interface{})),
+		}
+
+		if err := c.AddTeamCallback(d.Get("team_id").(string), callback); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceTeamCallbackRead(ctx, d, m)
+}
+
+func resourceTeamCallbackDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	// Logging cannot be re-enabled without knowing the callbacks to restore,
+	// so destroying a logging_disabled resource only removes it from state.
+	if d.Get("logging_disabled").(bool) {
+		d.SetId("")
+		return nil
+	}
+
+	teamID := d.Get("team_id").(string)
+	name := d.Get("callback_name").(string)
+
+	settings, err := c.GetTeamCallbacks(teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if settings == nil || teamCallbackType(settings, name) == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if err := c.DisableTeamLogging(teamID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	vars := make(map[string]string)
+	for k, v := range settings.CallbackVars {
+		if _, owned := d.Get("callback_vars").(map[string]interface{})[k]; !owned {
+			vars[k] = v
+		}
+	}
+
+	others := append([]string{}, settings.SuccessCallbacks...)
+	others = append(others, settings.FailureCallbacks...)
+
+	seen := map[string]bool{name: true}
+	for _, other := range others {
+		if seen[other] {
+			continue
+		}
+		seen[other] = true
+
+		callback := &client.TeamCallback{
+			CallbackName: other,
+			CallbackType: teamCallbackType(settings, other),
+			CallbackVars: vars,
+		}
+		if err := c.AddTeamCallback(teamID, callback); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to restore callback %s after removing %s: %w", other, name, err))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceTeamCallbackImport accepts "team_id/callback_name" for a callback,
+// or a bare team ID for a team with logging disabled. callback_vars are
+// shared by the team's callbacks, so they are not imported.
+func resourceTeamCallbackImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if parts[0] == "" || (len(parts) == 2 && parts[1] == "") {
+		return nil, fmt.Errorf("unexpected import ID %q, expected team_id/callback_name or team_id", d.Id())
+	}
+
+	d.Set("team_id", parts[0])
+	d.Set("callback_type", "success_and_failure")
+	if len(parts) == 1 {
+		d.Set("logging_disabled", true)
+	} else {
+		d.Set("logging_disabled", false)
+		d.Set("callback_name", parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTeamCallbackCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("logging_disabled").(bool) {
+		if d.Get("callback_name").(string) != "" || len(d.Get("callback_vars").(map[string]interface{})) > 0 {
+			return fmt.Errorf("callback_name and callback_vars cannot be set when logging_disabled is true")
+		}
+		return nil
+	}
+
+	if d.NewValueKnown("callback_name") && d.Get("callback_name").(string) == "" {
+		return fmt.Errorf("callback_name is required unless logging_disabled is true")
+	}
+
+	return nil
+}
+
+// teamCallbackType reports how the named callback is registered on the team,
+// or "" if it is not registered.
+func teamCallbackType(settings *client.TeamCallbackSettings, name string) string {
+	success, failure := false, false
+	for _, cb := range settings.SuccessCallbacks {
+		if cb == name {
+			success = true
+		}
+	}
+	for _, cb := range settings.FailureCallbacks {
+		if cb == name {
+			failure = true
+		}
+	}
+
+	switch {
+	case success && failure:
+		return "success_and_failure"
+	case success:
+		return "success"
+	case failure:
+		return "failure"
+	}
+	return ""
+}
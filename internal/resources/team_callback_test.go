@@ -0,0 +1,64 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceTeamCallback_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamCallbackConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_team_callback.compliance", "callback_name", "langfuse"),
+					resource.TestCheckResourceAttr(
+						"litellm_team_callback.compliance", "callback_type", "success"),
+					resource.TestCheckResourceAttr(
+						"litellm_team_callback.compliance", "callback_vars.langfuse_host", "https://cloud.langfuse.com"),
+					resource.TestCheckResourceAttr(
+						"litellm_team_callback.quiet", "logging_disabled", "true"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:            "litellm_team_callback.compliance",
+				ImportState:             true,
+				ImportStateId:           "compliance-team/langfuse",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"callback_vars"},
+			},
+			{
+				ResourceName:      "litellm_team_callback.quiet",
+				ImportState:       true,
+				ImportStateId:     "product-team",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTeamCallbackConfig_basic() string {
+	return `
+resource "litellm_team_callback" "compliance" {
+  team_id       = "compliance-team"
+  callback_name = "langfuse"
+  callback_type = "success"
+  callback_vars = {
+    langfuse_public_key = "pk-test"
+    langfuse_secret_key = "sk-test"
+    langfuse_host       = "https://cloud.langfuse.com"
+  }
+}
+
+resource "litellm_team_callback" "quiet" {
+  team_id          = "product-team"
+  logging_disabled = true
+}
+`
+}
@@ -0,0 +1,177 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceTeamMemberPermissions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMemberPermissionsCreate,
+		ReadContext:   resourceTeamMemberPermissionsRead,
+		UpdateContext: resourceTeamMemberPermissionsUpdate,
+		DeleteContext: resourceTeamMemberPermissionsDelete,
+		CustomizeDiff: resourceTeamMemberPermissionsCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamMemberPermissionsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The team whose member permissions are managed",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Routes team members may call, e.g. `/key/generate` or `/key/update`",
+			},
+			"previous_permissions": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "The permissions team members had before Terraform managed them, restored when the resource is destroyed",
+			},
+		},
+	}
+}
+
+func resourceTeamMemberPermissionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	teamID := d.Get("team_id").(string)
+
+	previous, err := c.GetTeamMemberPermissions(teamID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if previous == nil {
+		return diag.Errorf("team %q not found", teamID)
+	}
+	d.Set("previous_permissions", previous.TeamMemberPermissions)
+
+	if err := c.UpdateTeamMemberPermissions(teamID, expandStringSet(d.Get("permissions").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(teamID)
+
+	return resourceTeamMemberPermissionsRead(ctx, d, m)
+}
+
+func resourceTeamMemberPermissionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	permissions, err := c.GetTeamMemberPermissions(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if permissions == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("team_id", d.Id())
+	d.Set("permissions", permissions.TeamMemberPermissions)
+
+	return nil
+}
+
+func resourceTeamMemberPermissionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.UpdateTeamMemberPermissions(d.Id(), expandStringSet(d.Get("permissions").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceTeamMemberPermissionsRead(ctx, d, m)
+}
+
+func resourceTeamMemberPermissionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	previous := expandStringSet(d.Get("previous_permissions").(*schema.Set))
+	if err := c.UpdateTeamMemberPermissions(d.Id(), previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceTeamMemberPermissionsImport records the team's current permissions
+// as the ones to restore on destroy, since what it had before is unknown.
+func resourceTeamMemberPermissionsImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	c := m.(*client.Client)
+
+	current, err := c.GetTeamMemberPermissions(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, fmt.Errorf("team %q not found", d.Id())
+	}
+
+	d.Set("previous_permissions", current.TeamMemberPermissions)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceTeamMemberPermissionsCustomizeDiff rejects routes the proxy does
+// not allow to be granted to team members.
+func resourceTeamMemberPermissionsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("permissions") || !d.NewValueKnown("permissions") || !d.NewValueKnown("team_id") {
+		return nil
+	}
+
+	c := m.(*client.Client)
+
+	current, err := c.GetTeamMemberPermissions(d.Get("team_id").(string))
+	if err != nil {
+		return fmt.Errorf("failed to list available team member permissions: %w", err)
+	}
+	if current == nil {
+		return nil
+	}
+
+	available := make(map[string]bool, len(current.AllAvailablePermissions))
+	for _, p := range current.AllAvailablePermissions {
+		available[p] = true
+	}
+
+	var invalid []string
+	for _, p := range expandStringSet(d.Get("permissions").(*schema.Set)) {
+		if !available[p] {
+			invalid = append(invalid, p)
+		}
+	}
+
+	if len(invalid) > 0 {
+		sort.Strings(invalid)
+		return fmt.Errorf("permissions not available on this proxy: %s (available: %s)",
+			strings.Join(invalid, ", "), strings.Join(current.AllAvailablePermissions, ", "))
+	}
+
+	return nil
+}
+
+func expandStringSet(s *schema.Set) []string {
+	return expandStringList(s.List())
+}
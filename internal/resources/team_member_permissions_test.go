@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceTeamMemberPermissions_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamMemberPermissionsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_team_member_permissions.test", "team_id", "test-team"),
+					resource.TestCheckResourceAttr(
+						"litellm_team_member_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"litellm_team_member_permissions.test", "permissions.*", "/key/generate"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_team_member_permissions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// An import records the team's current permissions, not the pre-create ones.
+				ImportStateVerifyIgnore: []string{"previous_permissions"},
+			},
+			{
+				Config:      testAccResourceTeamMemberPermissionsConfig_invalid(),
+				ExpectError: regexp.MustCompile(`permissions not available on this proxy: /model/new`),
+			},
+		},
+	})
+}
+
+func testAccResourceTeamMemberPermissionsConfig_basic() string {
+	return `
+resource "litellm_team_member_permissions" "test" {
+  team_id     = "test-team"
+  permissions = ["/key/generate", "/key/update"]
+}
+`
+}
+
+func testAccResourceTeamMemberPermissionsConfig_invalid() string {
+	return `
+resource "litellm_team_member_permissions" "test" {
+  team_id     = "test-team"
+  permissions = ["/key/generate", "/model/new"]
+}
+`
+}
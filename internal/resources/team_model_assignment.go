@@ -0,0 +1,114 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+func ResourceTeamModelAssignment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamModelAssignmentCreate,
+		ReadContext:   resourceTeamModelAssignmentRead,
+		DeleteContext: resourceTeamModelAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamModelAssignmentImport,
+		},
+
+		Description: "Grants a single model to a team through `/team/model/add`, leaving the team's " +
+			"other models untouched, so several configurations can each grant models to the same team.\n\n" +
+			"Do not combine this resource with anything that manages the team's `models` list " +
+			"authoritatively (for example a team definition that sets `models`). The authoritative list " +
+			"overwrites grants made here on its next apply, and this resource then re-adds its model, " +
+			"so the two never converge.",
+
+		Schema: map[string]*schema.Schema{
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The team to grant the model to",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"model": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The model name to grant",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceTeamModelAssignmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	teamID := d.Get("team_id").(string)
+	model := d.Get("model").(string)
+
+	if err := c.AddTeamModels(teamID, []string{model}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", teamID, model))
+
+	return resourceTeamModelAssignmentRead(ctx, d, m)
+}
+
+func resourceTeamModelAssignmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	team, err := c.GetTeam(d.Get("team_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if team == nil {
+		d.SetId("")
+		return nil
+	}
+
+	model := d.Get("model").(string)
+	for _, teamModel := range team.Models {
+		if teamModel == model {
+			return nil
+		}
+	}
+
+	// The model was removed from the team outside this resource
+	d.SetId("")
+
+	return nil
+}
+
+func resourceTeamModelAssignmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteTeamModels(d.Get("team_id").(string), []string{d.Get("model").(string)}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceTeamModelAssignmentImport accepts IDs of the form "team_id/model".
+// Model names may themselves contain slashes, so only the first one splits.
+func resourceTeamModelAssignmentImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected import ID %q, expected team_id/model", d.Id())
+	}
+
+	d.Set("team_id", parts[0])
+	d.Set("model", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
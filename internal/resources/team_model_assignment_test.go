@@ -0,0 +1,47 @@
+package resources_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceTeamModelAssignment_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamModelAssignmentConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_team_model_assignment.platform", "model", "gpt-4"),
+					resource.TestCheckResourceAttr(
+						"litellm_team_model_assignment.product", "model", "openai/gpt-4o-mini"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_team_model_assignment.product",
+				ImportState:       true,
+				ImportStateId:     "test-team/openai/gpt-4o-mini",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceTeamModelAssignmentConfig_basic() string {
+	return `
+resource "litellm_team_model_assignment" "platform" {
+  team_id = "test-team"
+  model   = "gpt-4"
+}
+
+resource "litellm_team_model_assignment" "product" {
+  team_id = "test-team"
+  model   = "openai/gpt-4o-mini"
+}
+`
+}
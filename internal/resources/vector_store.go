@@ -0,0 +1,134 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/client"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/validation"
+)
+
+// ResourceVectorStore has no update function: the proxy cannot modify a
+// vector store in place, so every attribute forces replacement.
+func ResourceVectorStore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVectorStoreCreate,
+		ReadContext:   resourceVectorStoreRead,
+		DeleteContext: resourceVectorStoreDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vector_store_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The provider's ID for the vector store, e.g. a Bedrock knowledge base ID",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"custom_llm_provider": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The provider hosting the vector store (e.g., 'bedrock', 'openai')",
+				ValidateFunc: validation.StringNotEmpty,
+			},
+			"vector_store_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the vector store",
+			},
+			"vector_store_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the vector store",
+			},
+			"vector_store_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional metadata for the vector store",
+			},
+			"litellm_credential_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of a `litellm_credential` used to access the vector store",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation timestamp of the vector store",
+			},
+		},
+	}
+}
+
+func resourceVectorStoreCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	store := &client.VectorStore{
+		VectorStoreID:          d.Get("vector_store_id").(string),
+		CustomLLMProvider:      d.Get("custom_llm_provider").(string),
+		VectorStoreName:        d.Get("vector_store_name").(string),
+		VectorStoreDescription: d.Get("vector_store_description").(string),
+		LiteLLMCredentialName:  d.Get("litellm_credential_name").(string),
+	}
+
+	if v, ok := d.GetOk("vector_store_metadata"); ok {
+		metadata := make(map[string]string)
+		for k, v := range v.(map[string]interface{}) {
+			metadata[k] = v.(string)
+		}
+		store.VectorStoreMetadata = metadata
+	}
+
+	if err := c.CreateVectorStore(store); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(store.VectorStoreID)
+
+	return resourceVectorStoreRead(ctx, d, m)
+}
+
+func resourceVectorStoreRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	store, err := c.GetVectorStore(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if store == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vector_store_id", store.VectorStoreID)
+	d.Set("custom_llm_provider", store.CustomLLMProvider)
+	d.Set("vector_store_name", store.VectorStoreName)
+	d.Set("vector_store_description", store.VectorStoreDescription)
+	d.Set("vector_store_metadata", store.VectorStoreMetadata)
+	d.Set("litellm_credential_name", store.LiteLLMCredentialName)
+	d.Set("created_at", store.CreatedAt)
+
+	return nil
+}
+
+func resourceVectorStoreDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*client.Client)
+
+	if err := c.DeleteVectorStore(d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
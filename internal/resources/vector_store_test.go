@@ -0,0 +1,59 @@
+package resources_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/jimmyflatting/terraform-provider-litellm/internal/provider"
+)
+
+func TestAccResourceVectorStore_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { provider.TestAccPreCheck(t) },
+		ProviderFactories: provider.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVectorStoreConfig_basic("Product docs"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_vector_store.test", "vector_store_id", "T37J8R4WTM"),
+					resource.TestCheckResourceAttr(
+						"litellm_vector_store.test", "custom_llm_provider", "bedrock"),
+					resource.TestCheckResourceAttr(
+						"litellm_vector_store.test", "vector_store_description", "Product docs"),
+					resource.TestCheckResourceAttr(
+						"litellm_vector_store.test", "vector_store_metadata.team", "rag"),
+				),
+			},
+			// Changing the description replaces the vector store
+			{
+				Config: testAccResourceVectorStoreConfig_basic("Product docs v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"litellm_vector_store.test", "vector_store_description", "Product docs v2"),
+				),
+			},
+			// Import test
+			{
+				ResourceName:      "litellm_vector_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceVectorStoreConfig_basic(description string) string {
+	return fmt.Sprintf(`
+resource "litellm_vector_store" "test" {
+  vector_store_id          = "T37J8R4WTM"
+  custom_llm_provider      = "bedrock"
+  vector_store_name        = "product-docs"
+  vector_store_description = %q
+  vector_store_metadata = {
+    team = "rag"
+  }
+}
+`, description)
+}
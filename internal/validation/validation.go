@@ -1,7 +1,14 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -46,6 +53,20 @@ func FloatGreaterThanOrEqual(min float64) schema.SchemaValidateFunc {
 	}
 }
 
+// IntGreaterThanOrEqual validates that an int value is greater than or equal to a minimum value
+func IntGreaterThanOrEqual(min int) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) ([]string, []error) {
+		v, ok := i.(int)
+		if !ok {
+			return nil, []error{fmt.Errorf("expected type of %s to be int", k)}
+		}
+		if v < min {
+			return nil, []error{fmt.Errorf("%s cannot be less than %d", k, min)}
+		}
+		return nil, nil
+	}
+}
+
 // OneOf validates that a string value is one of a set of values
 func OneOf(valid ...string) schema.SchemaValidateFunc {
 	return func(i interface{}, k string) ([]string, []error) {
@@ -61,3 +82,120 @@ func OneOf(valid ...string) schema.SchemaValidateFunc {
 		return nil, []error{fmt.Errorf("expected %s to be one of %v, got %s", k, valid, v)}
 	}
 }
+
+// URLPath validates that a string value is an absolute URL path, e.g. "/openai-eu"
+func URLPath(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if !strings.HasPrefix(v, "/") {
+		return nil, []error{fmt.Errorf("%s must start with a leading slash, got %q", k, v)}
+	}
+	if strings.ContainsAny(v, " \t\n?#") {
+		return nil, []error{fmt.Errorf("%s must be a plain path without whitespace, query or fragment, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// AbsoluteURL validates that a string value is an absolute http or https URL
+func AbsoluteURL(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	u, err := url.Parse(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s must be a valid URL: %v", k, err)}
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, []error{fmt.Errorf("%s must be an absolute http or https URL, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+// IPOrCIDR validates that a string value is an IPv4/IPv6 address or CIDR block
+func IPOrCIDR(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if net.ParseIP(v) != nil {
+		return nil, nil
+	}
+	if _, _, err := net.ParseCIDR(v); err == nil {
+		return nil, nil
+	}
+	return nil, []error{fmt.Errorf("%s must be a valid IP address or CIDR block, got %q", k, v)}
+}
+
+// ParseDuration parses a Go duration such as "720h", or a whole number of
+// days such as "30d" as used for LiteLLM budget durations
+func ParseDuration(s string) (time.Duration, error) {
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.Atoi(strings.TrimSuffix(s, "d"))
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid number of days in %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("duration %q must be positive", s)
+	}
+	return d, nil
+}
+
+// Duration validates that a string value can be parsed by ParseDuration
+func Duration(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := ParseDuration(v); err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration like \"720h\" or \"30d\": %v", k, err)}
+	}
+	return nil, nil
+}
+
+// StringIsJSON validates that a string value is a valid JSON document
+func StringIsJSON(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if !json.Valid([]byte(v)) {
+		return nil, []error{fmt.Errorf("%s must be valid JSON", k)}
+	}
+	return nil, nil
+}
+
+// StringIsJSONObject validates that a string value is a JSON object
+func StringIsJSONObject(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(v), &obj); err != nil || obj == nil {
+		return nil, []error{fmt.Errorf("%s must be a JSON object", k)}
+	}
+	return nil, nil
+}
+
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvVarName validates that a string value is a valid environment variable name
+func EnvVarName(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if !envVarNamePattern.MatchString(v) {
+		return nil, []error{fmt.Errorf("%s must be an environment variable name of letters, digits and underscores, not starting with a digit, got %q", k, v)}
+	}
+	return nil, nil
+}